@@ -6,6 +6,7 @@ import (
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen/config"
 
+	"github.com/danutavadanei/gqlgen-validate/example/permissions"
 	"github.com/danutavadanei/gqlgen-validate/gen"
 )
 
@@ -15,7 +16,11 @@ func main() {
 		log.Fatal(err)
 	}
 
-	if err = api.Generate(cfg, api.AddPlugin(gen.New())); err != nil {
+	if err = api.Generate(cfg, gen.AddPlugin(
+		gen.WithStaticValidation(),
+		gen.WithScalarRules(map[string]string{"ID": "numeric"}),
+		gen.WithCustomTags(permissions.GrantableTag, "unregistered"),
+	)); err != nil {
 		log.Fatal(err)
 	}
 }
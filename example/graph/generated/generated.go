@@ -51,7 +51,8 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Users func(childComplexity int) int
+		User  func(childComplexity int, id string) int
+		Users func(childComplexity int, limit *int) int
 	}
 
 	QuestionnaireAnswer struct {
@@ -66,6 +67,7 @@ type ComplexityRoot struct {
 		ID                   func(childComplexity int) int
 		Password             func(childComplexity int) int
 		QuestionnaireAnswers func(childComplexity int) int
+		Role                 func(childComplexity int) int
 		TermsAndConditions   func(childComplexity int) int
 	}
 }
@@ -74,7 +76,8 @@ type MutationResolver interface {
 	RegisterUser(ctx context.Context, input model.RegisterUserInput) (*model.User, error)
 }
 type QueryResolver interface {
-	Users(ctx context.Context) ([]*model.User, error)
+	Users(ctx context.Context, limit *int) ([]*model.User, error)
+	User(ctx context.Context, id string) (*model.User, error)
 }
 
 type executableSchema struct {
@@ -108,12 +111,28 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.RegisterUser(childComplexity, args["input"].(model.RegisterUserInput)), true
 
+	case "Query.user":
+		if e.complexity.Query.User == nil {
+			break
+		}
+
+		args, err := ec.field_Query_user_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.User(childComplexity, args["id"].(string)), true
 	case "Query.users":
 		if e.complexity.Query.Users == nil {
 			break
 		}
 
-		return e.complexity.Query.Users(childComplexity), true
+		args, err := ec.field_Query_users_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Users(childComplexity, args["limit"].(*int)), true
 
 	case "QuestionnaireAnswer.answerId":
 		if e.complexity.QuestionnaireAnswer.AnswerID == nil {
@@ -164,6 +183,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.User.QuestionnaireAnswers(childComplexity), true
+	case "User.role":
+		if e.complexity.User.Role == nil {
+			break
+		}
+
+		return e.complexity.User.Role(childComplexity), true
 	case "User.termsAndConditions":
 		if e.complexity.User.TermsAndConditions == nil {
 			break
@@ -179,8 +204,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAnswerInput,
 		ec.unmarshalInputQuestionnaireAnswerInput,
 		ec.unmarshalInputRegisterUserInput,
+		ec.unmarshalInputValidateMessage,
 	)
 	first := true
 
@@ -278,12 +305,16 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
-	{Name: "../schema.gql", Input: `"""Input validation directive (e.g., @validate(rule: "required,min=8"))."""
-directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+	{Name: "../schema.gql", Input: `# The @validate family of directives is declared by the gqlgen-validate plugin.
+
+extend schema @validateAlias(name: "emailAddress", rule: "required,email,max=254")
 
 type Query {
-  """Fetch all users."""
-  users: [User!]!
+  """Fetch users, optionally limited to the first ` + "`" + `limit` + "`" + ` entries."""
+  users(limit: Int @validate(rule: "omitempty,gte=1,lte=100")): [User!]!
+
+  """Fetch a single user by ID."""
+  user(id: ID! @validate(rule: "numeric", message: "id must be numeric")): User
 }
 
 type Mutation {
@@ -299,7 +330,7 @@ type User {
   id: ID!
 
   """Primary email address."""
-  email: String!
+  email: String! @validate(rule: "email")
 
   """Age in years (optional)."""
   age: Int
@@ -315,6 +346,17 @@ type User {
 
   """Answers provided to the onboarding questionnaire."""
   questionnaireAnswers: [QuestionnaireAnswer!]!
+
+  """Role of the user."""
+  role: Role!
+}
+
+"""
+Role of a user. Callers may grant their own role or a lower one.
+"""
+enum Role {
+  MEMBER
+  ADMIN
 }
 
 """
@@ -334,24 +376,74 @@ type QuestionnaireAnswer {
 
 """
 Input counterpart for questionnaire answers.
-- Either ` + "`" + `answerId` + "`" + ` or ` + "`" + `answerText` + "`" + ` is required.
 """
 input QuestionnaireAnswerInput {
   questionId: ID! @validate(rule: "required")
-  answerId: ID @validate(rule: "required_without=answerText,excluded_with=answerText")
-  answerText: String @validate(rule: "required_without=answerId,excluded_with=answerId")
+  answer: AnswerInput!
+}
+
+"""
+An answer to a question: exactly one of ` + "`" + `id` + "`" + ` and ` + "`" + `text` + "`" + ` must be provided.
+"""
+input AnswerInput @oneOf {
+  """Chosen option ID, if the question is multiple-choice."""
+  id: ID
+
+  """Free-text answer, if applicable."""
+  text: String
 }
 
 """
 Input for user registration.
 """
 input RegisterUserInput {
-  email: String! @validate(rule: "required,email")
-  password: String! @validate(rule: "required,min=8")
+  email: String! @validateTransform(ops: ["trim", "lower"]) @validate(rule: "emailAddress,unregistered", messages: [
+    {rule: "unregistered", text: "{value} is already registered"}
+  ])
+  password: String! @validate(rule: "required,min=8", messages: [
+    {rule: "required", text: "Password is required"},
+    {rule: "min", text: "Password must be at least {param} characters"}
+  ])
   confirmPassword: String! @validate(rule: "eqfield=password")
-  age: Int @validate(rule: "omitempty,gte=18", message: "Age must be 18+ or left blank")
-  termsAndConditions: [ID!]! @validate(rule: "required,min=1")
+  age: Int @validate(rule: "omitempty,gte=18", message: "Age must be 18+ or left blank (got {value})", messages: [
+    {locale: "de", text: "Das Alter muss mindestens 18 sein oder leer bleiben"}
+  ])
+  termsAndConditions: [ID!]! @validate(rule: "required,min=1", each: "numeric")
   questionnaireAnswers: [QuestionnaireAnswerInput!] @validate(rule: "required,min=1,dive")
+  role: Role @validate(rule: "omitempty,grantable", message: "the {value} role cannot be granted by the caller")
+}
+`, BuiltIn: false},
+	{Name: "../../gqlgen-validate.graphql", Input: `"""
+Validates an input field, argument or output field (e.g., @validate(rule: "required,min=8")).
+"""
+directive @validate(rule: String, each: [String!], message: String, messageKey: String, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+"""
+Validates an input object as a whole (e.g., @validateStruct(rule: "required_any=email phone")).
+"""
+directive @validateStruct(rule: String!, message: String) repeatable on INPUT_OBJECT
+"""
+Declares a named rule usable wherever @validate takes a rule.
+"""
+directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+"""
+Excludes a field or argument from validation.
+"""
+directive @skipValidate on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+"""
+Normalizes a string input before it is validated (e.g., @validateTransform(ops: ["trim", "lower"])).
+"""
+directive @validateTransform(ops: [String!]!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+"""
+Fills an omitted or null input field before it is validated (e.g., @validateDefault(name: "now")).
+"""
+directive @validateDefault(name: String!) on INPUT_FIELD_DEFINITION
+"""
+A custom validation message for one rule, locale or both.
+"""
+input ValidateMessage {
+	locale: String
+	rule: String
+	text: String!
 }
 `, BuiltIn: false},
 }
@@ -383,6 +475,28 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -472,6 +586,8 @@ func (ec *executionContext) fieldContext_Mutation_registerUser(ctx context.Conte
 				return ec.fieldContext_User_termsAndConditions(ctx, field)
 			case "questionnaireAnswers":
 				return ec.fieldContext_User_questionnaireAnswers(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -497,7 +613,8 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 		field,
 		ec.fieldContext_Query_users,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Users(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Users(ctx, fc.Args["limit"].(*int))
 		},
 		nil,
 		ec.marshalNUser2ᚕᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐUserᚄ,
@@ -506,7 +623,64 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_users(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_users(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "age":
+				return ec.fieldContext_User_age(ctx, field)
+			case "password":
+				return ec.fieldContext_User_password(ctx, field)
+			case "termsAndConditions":
+				return ec.fieldContext_User_termsAndConditions(ctx, field)
+			case "questionnaireAnswers":
+				return ec.fieldContext_User_questionnaireAnswers(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_users_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_user,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().User(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOUser2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐUser,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -526,10 +700,23 @@ func (ec *executionContext) fieldContext_Query_users(_ context.Context, field gr
 				return ec.fieldContext_User_termsAndConditions(ctx, field)
 			case "questionnaireAnswers":
 				return ec.fieldContext_User_questionnaireAnswers(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_user_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -910,6 +1097,35 @@ func (ec *executionContext) fieldContext_User_questionnaireAnswers(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _User_role(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -2356,6 +2572,40 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputAnswerInput(ctx context.Context, obj any) (model.AnswerInput, error) {
+	var it model.AnswerInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "text"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "text":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("text"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Text = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputQuestionnaireAnswerInput(ctx context.Context, obj any) (model.QuestionnaireAnswerInput, error) {
 	var it model.QuestionnaireAnswerInput
 	asMap := map[string]any{}
@@ -2363,7 +2613,7 @@ func (ec *executionContext) unmarshalInputQuestionnaireAnswerInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"questionId", "answerId", "answerText"}
+	fieldsInOrder := [...]string{"questionId", "answer"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -2377,20 +2627,13 @@ func (ec *executionContext) unmarshalInputQuestionnaireAnswerInput(ctx context.C
 				return it, err
 			}
 			it.QuestionID = data
-		case "answerId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("answerId"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AnswerID = data
-		case "answerText":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("answerText"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+		case "answer":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("answer"))
+			data, err := ec.unmarshalNAnswerInput2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐAnswerInput(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.AnswerText = data
+			it.Answer = data
 		}
 	}
 
@@ -2404,7 +2647,7 @@ func (ec *executionContext) unmarshalInputRegisterUserInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"email", "password", "confirmPassword", "age", "termsAndConditions", "questionnaireAnswers"}
+	fieldsInOrder := [...]string{"email", "password", "confirmPassword", "age", "termsAndConditions", "questionnaireAnswers", "role"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -2453,6 +2696,54 @@ func (ec *executionContext) unmarshalInputRegisterUserInput(ctx context.Context,
 				return it, err
 			}
 			it.QuestionnaireAnswers = data
+		case "role":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("role"))
+			data, err := ec.unmarshalORole2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Role = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputValidateMessage(ctx context.Context, obj any) (model.ValidateMessage, error) {
+	var it model.ValidateMessage
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"locale", "rule", "text"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
+		case "rule":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rule"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rule = data
+		case "text":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("text"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Text = data
 		}
 	}
 
@@ -2556,6 +2847,25 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "user":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_user(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -2669,6 +2979,11 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "role":
+			out.Values[i] = ec._User_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3027,6 +3342,11 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) unmarshalNAnswerInput2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐAnswerInput(ctx context.Context, v any) (*model.AnswerInput, error) {
+	res, err := ec.unmarshalInputAnswerInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -3153,6 +3473,16 @@ func (ec *executionContext) unmarshalNRegisterUserInput2githubᚗcomᚋdanutavad
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRole2githubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole(ctx context.Context, v any) (model.Role, error) {
+	var res model.Role
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRole2githubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole(ctx context.Context, sel ast.SelectionSet, v model.Role) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -3169,6 +3499,36 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNUser2githubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v model.User) graphql.Marshaler {
 	return ec._User(ctx, sel, &v)
 }
@@ -3227,6 +3587,11 @@ func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlge
 	return ec._User(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNValidateMessage2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐValidateMessage(ctx context.Context, v any) (*model.ValidateMessage, error) {
+	res, err := ec.unmarshalInputValidateMessage(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -3564,6 +3929,58 @@ func (ec *executionContext) unmarshalOQuestionnaireAnswerInput2ᚕᚖgithubᚗco
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalORole2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole(ctx context.Context, v any) (*model.Role, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Role)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORole2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐRole(ctx context.Context, sel ast.SelectionSet, v *model.Role) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -3582,6 +3999,31 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOUser2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOValidateMessage2ᚕᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐValidateMessageᚄ(ctx context.Context, v any) ([]*model.ValidateMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.ValidateMessage, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNValidateMessage2ᚖgithubᚗcomᚋdanutavadaneiᚋgqlgenᚑvalidateᚋexampleᚋgraphᚋmodelᚐValidateMessage(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2,6 +2,21 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// An answer to a question: exactly one of `id` and `text` must be provided.
+type AnswerInput struct {
+	// Chosen option ID, if the question is multiple-choice.
+	ID *string `json:"id,omitempty" validate:"omitnil,numeric"`
+	// Free-text answer, if applicable.
+	Text *string `json:"text,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -20,21 +35,20 @@ type QuestionnaireAnswer struct {
 }
 
 // Input counterpart for questionnaire answers.
-// - Either `answerId` or `answerText` is required.
 type QuestionnaireAnswerInput struct {
-	QuestionID string  `json:"questionId" validate:"required"`
-	AnswerID   *string `json:"answerId,omitempty" validate:"required_without=AnswerText,excluded_with=AnswerText"`
-	AnswerText *string `json:"answerText,omitempty" validate:"required_without=AnswerID,excluded_with=AnswerID"`
+	QuestionID string       `json:"questionId" validate:"required"`
+	Answer     *AnswerInput `json:"answer"`
 }
 
 // Input for user registration.
 type RegisterUserInput struct {
-	Email                string                      `json:"email" validate:"required,email"`
-	Password             string                      `json:"password" validate:"required,min=8"`
+	Email                string                      `json:"email" transform:"trim,lower" validate:"required,email,max=254,unregistered" message_unregistered:"{value} is already registered"`
+	Password             string                      `json:"password" validate:"required,min=8" message_required:"Password is required" message_min:"Password must be at least {param} characters"`
 	ConfirmPassword      string                      `json:"confirmPassword" validate:"eqfield=Password"`
-	Age                  *int                        `json:"age,omitempty" validate:"omitempty,gte=18" message:"Age must be 18+ or left blank"`
-	TermsAndConditions   []string                    `json:"termsAndConditions" validate:"required,min=1"`
+	Age                  *int                        `json:"age,omitempty" validate:"omitempty,gte=18" message:"Age must be 18+ or left blank (got {value})" message-de:"Das Alter muss mindestens 18 sein oder leer bleiben"`
+	TermsAndConditions   []string                    `json:"termsAndConditions" validate:"required,min=1,dive,numeric"`
 	QuestionnaireAnswers []*QuestionnaireAnswerInput `json:"questionnaireAnswers,omitempty" validate:"required,min=1,dive"`
+	Role                 *Role                       `json:"role,omitempty" validate:"omitempty,grantable" message:"the {value} role cannot be granted by the caller"`
 }
 
 // A user in the system.
@@ -42,7 +56,7 @@ type User struct {
 	// Unique identifier.
 	ID string `json:"id"`
 	// Primary email address.
-	Email string `json:"email"`
+	Email string `json:"email" validate:"email"`
 	// Age in years (optional).
 	Age *int `json:"age,omitempty"`
 	// Hashed password or placeholder (avoid returning this to clients).
@@ -52,4 +66,69 @@ type User struct {
 	TermsAndConditions []string `json:"termsAndConditions"`
 	// Answers provided to the onboarding questionnaire.
 	QuestionnaireAnswers []*QuestionnaireAnswer `json:"questionnaireAnswers"`
+	// Role of the user.
+	Role Role `json:"role"`
+}
+
+// A custom validation message for one rule, locale or both.
+type ValidateMessage struct {
+	Locale *string `json:"locale,omitempty"`
+	Rule   *string `json:"rule,omitempty"`
+	Text   string  `json:"text"`
+}
+
+// Role of a user. Callers may grant their own role or a lower one.
+type Role string
+
+const (
+	RoleMember Role = "MEMBER"
+	RoleAdmin  Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleMember,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleMember, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
@@ -2,6 +2,27 @@
 
 package model
 
+import (
+	"github.com/danutavadanei/gqlgen-validate/runtime"
+)
+
+func (AnswerInput) IsValidatable() {}
+
 func (QuestionnaireAnswerInput) IsValidatable() {}
 
 func (RegisterUserInput) IsValidatable() {}
+
+func init() {
+	runtime.RegisterStructRules(AnswerInput{},
+		runtime.StructRule{Name: "oneOf", Param: "id text"},
+	)
+	runtime.RegisterArguments("Query", "user",
+		runtime.Argument{Name: "id", Rule: "numeric", Message: "id must be numeric"},
+	)
+	runtime.RegisterArguments("Query", "users",
+		runtime.Argument{Name: "limit", Rule: "omitempty,gte=1,lte=100"},
+	)
+	runtime.RegisterResults("User",
+		runtime.Argument{Name: "email", Rule: "email"},
+	)
+}
@@ -0,0 +1,61 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"github.com/danutavadanei/gqlgen-validate/runtime"
+	validator "github.com/go-playground/validator/v10"
+)
+
+// Validate checks the @validate rules of AnswerInput without reflection and
+// returns validator.ValidationErrors when they fail.
+func (m AnswerInput) Validate(ctx context.Context) error {
+	if errs := m.validateFields("AnswerInput", "AnswerInput"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (m AnswerInput) validateFields(ns, sns string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if m.ID != nil {
+		if !runtime.MatchFormat("numeric", *m.ID) {
+			errs = append(errs, runtime.NewFieldError("numeric", "", ns+".id", sns+".ID", *m.ID))
+		}
+	}
+	{
+		set := 0
+		if m.ID != nil {
+			set++
+		}
+		if m.Text != nil {
+			set++
+		}
+		if set != 1 {
+			errs = append(errs, runtime.NewFieldError("oneOf", "id text", ns+".", sns+".", m))
+		}
+	}
+	return errs
+}
+
+// Validate checks the @validate rules of QuestionnaireAnswerInput without reflection and
+// returns validator.ValidationErrors when they fail.
+func (m QuestionnaireAnswerInput) Validate(ctx context.Context) error {
+	if errs := m.validateFields("QuestionnaireAnswerInput", "QuestionnaireAnswerInput"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (m QuestionnaireAnswerInput) validateFields(ns, sns string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if m.QuestionID == "" {
+		errs = append(errs, runtime.NewFieldError("required", "", ns+".questionId", sns+".QuestionID", m.QuestionID))
+	}
+	if m.Answer != nil {
+		errs = append(errs, m.Answer.validateFields(ns+".answer", sns+".Answer")...)
+	}
+	return errs
+}
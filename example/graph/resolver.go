@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"context"
+	"fmt"
 	"sync"
 
 	"github.com/danutavadanei/gqlgen-validate/example/graph/model"
@@ -11,3 +13,22 @@ type Resolver struct {
 	users  []*model.User
 	nextID int
 }
+
+// EmailAvailable reports whether no user registered the email address yet. It
+// backs the async "unregistered" validation of RegisterUserInput.email.
+func (r *Resolver) EmailAvailable(ctx context.Context, value any, _ string) (bool, error) {
+	email, ok := value.(string)
+	if !ok {
+		return false, fmt.Errorf("email addresses are strings (got %T)", value)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.Email == email {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -18,6 +18,9 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input model.Registe
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// The unregistered rule reports taken addresses on the email field before
+	// the resolver runs, but another request may register the address in
+	// between, so the store still rejects duplicates.
 	for _, existing := range r.users {
 		if existing.Email == input.Email {
 			return nil, errors.New("user with that email already exists")
@@ -28,15 +31,18 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input model.Registe
 	questionnaireAnswers := make([]*model.QuestionnaireAnswer, 0, len(input.QuestionnaireAnswers))
 	for _, qa := range input.QuestionnaireAnswers {
 		mqa := &model.QuestionnaireAnswer{QuestionID: qa.QuestionID}
-		if qa.AnswerID != nil {
-			mqa.AnswerID = qa.AnswerID
-		}
-		if qa.AnswerText != nil {
-			mqa.AnswerText = qa.AnswerText
+		if qa.Answer != nil {
+			mqa.AnswerID = qa.Answer.ID
+			mqa.AnswerText = qa.Answer.Text
 		}
 		questionnaireAnswers = append(questionnaireAnswers, mqa)
 	}
 
+	role := model.RoleMember
+	if input.Role != nil {
+		role = *input.Role
+	}
+
 	user := &model.User{
 		ID:                   strconv.Itoa(r.nextID),
 		Email:                input.Email,
@@ -44,6 +50,7 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input model.Registe
 		Password:             input.Password,
 		TermsAndConditions:   append([]string(nil), input.TermsAndConditions...),
 		QuestionnaireAnswers: questionnaireAnswers,
+		Role:                 role,
 	}
 
 	r.users = append(r.users, user)
@@ -51,15 +58,33 @@ func (r *mutationResolver) RegisterUser(ctx context.Context, input model.Registe
 }
 
 // Users is the resolver for the users field.
-func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
+func (r *queryResolver) Users(ctx context.Context, limit *int) ([]*model.User, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	out := make([]*model.User, len(r.users))
-	copy(out, r.users)
+	users := r.users
+	if limit != nil && *limit < len(users) {
+		users = users[:*limit]
+	}
+
+	out := make([]*model.User, len(users))
+	copy(out, users)
 	return out, nil
 }
 
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -0,0 +1,35 @@
+// Package permissions implements the permission-based validation rules of the
+// example.
+package permissions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/danutavadanei/gqlgen-validate/runtime"
+)
+
+// GrantableTag is the validation tag of roles the caller may grant.
+const GrantableTag = "grantable"
+
+// RoleHeader carries the role of the caller. A real server would take it from
+// the authenticated session instead.
+const RoleHeader = "X-Role"
+
+// ranks orders the roles of the schema.
+var ranks = map[string]int{"MEMBER": 1, "ADMIN": 2}
+
+// Grantable reports whether the caller of the GraphQL operation may grant the
+// role held by the field: its own role or a lower one. Outside of a GraphQL
+// request no role can be granted.
+func Grantable(ctx context.Context, fl validator.FieldLevel) bool {
+	oc := runtime.OperationContext(ctx)
+	if oc == nil {
+		return false
+	}
+
+	caller := ranks[strings.ToUpper(oc.Headers.Get(RoleHeader))]
+	return caller > 0 && ranks[fl.Field().String()] <= caller
+}
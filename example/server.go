@@ -15,6 +15,7 @@ import (
 
 	"github.com/danutavadanei/gqlgen-validate/example/graph"
 	"github.com/danutavadanei/gqlgen-validate/example/graph/generated"
+	"github.com/danutavadanei/gqlgen-validate/example/permissions"
 	"github.com/danutavadanei/gqlgen-validate/runtime"
 )
 
@@ -22,8 +23,18 @@ func main() {
 	resolver := &graph.Resolver{}
 	cfg := generated.Config{Resolvers: resolver}
 
+	validator, err := runtime.New(
+		runtime.WithResultValidation(runtime.ResultLog),
+		runtime.WithValidationCtx(permissions.GrantableTag, permissions.Grantable),
+		runtime.WithAsyncValidation("unregistered", resolver.EmailAvailable),
+	)
+	if err != nil {
+		log.Fatalf("validator: %v", err)
+	}
+
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(cfg))
-	srv.AroundFields(runtime.Middleware())
+	srv.AroundOperations(validator.OperationMiddleware())
+	srv.AroundFields(validator.Middleware())
 
 	mux := http.NewServeMux()
 	mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
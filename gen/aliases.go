@@ -0,0 +1,108 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// aliasDirectiveName identifies the schema directive declaring rule aliases.
+const aliasDirectiveName = "validateAlias"
+
+// collectAliases records the @validateAlias directives of the schema, checks
+// every alias, including those passed with WithAliases, and adds the alias names
+// to the known tags. Alias rules are checked for their tags only: references to
+// fields are checked where the alias is used.
+func (p *Plugin) collectAliases(schema *ast.Schema) ([]ruleError, error) {
+	positions := make(map[string]*ast.Position)
+	for _, directive := range schema.SchemaDirectives.ForNames(aliasDirectiveName) {
+		name, err := getArgumentValueAsString(directive.Arguments.ForName("name"))
+		if err != nil {
+			return nil, fmt.Errorf("@%s requires a name", aliasDirectiveName)
+		}
+		ruleArg := directive.Arguments.ForName("rule")
+		rule, err := getArgumentValueAsString(ruleArg)
+		if err != nil {
+			return nil, fmt.Errorf("@%s %q requires a rule", aliasDirectiveName, name)
+		}
+		if _, ok := p.aliases[name]; ok {
+			return nil, fmt.Errorf("duplicate @%s %q", aliasDirectiveName, name)
+		}
+
+		p.aliases[name] = rule
+		positions[name] = ruleArg.Position
+		if ruleArg.Value != nil && ruleArg.Value.Position != nil {
+			positions[name] = ruleArg.Value.Position
+		}
+	}
+
+	names := make([]string, 0, len(p.aliases))
+	for name := range p.aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []ruleError
+	for _, name := range names {
+		if name == "" || strings.ContainsAny(name, ",|= \t") {
+			return nil, fmt.Errorf("invalid alias name %q", name)
+		}
+
+		var messages []string
+		if p.tags.contains(name) {
+			messages = append(messages, "shadows the tag of the same name")
+		}
+		tags := ruleTags(p.aliases[name]).values()
+		sort.Strings(tags)
+		for _, tag := range tags {
+			if !p.tags.contains(tag) {
+				messages = append(messages, fmt.Sprintf("unknown tag %q", tag))
+			}
+		}
+		for _, message := range messages {
+			problems = append(problems, ruleError{pos: positions[name], message: fmt.Sprintf("alias %q: %s", name, message)})
+		}
+	}
+
+	for _, name := range names {
+		p.tags.add(name)
+	}
+	return problems, nil
+}
+
+// expandAliases replaces the aliases used as whole tags of rule with their
+// rules. Aliases in '|' groups are left alone, as validator would, and reported
+// by aliasProblems.
+func (p *Plugin) expandAliases(rule string) string {
+	if len(p.aliases) == 0 {
+		return rule
+	}
+
+	segments := strings.Split(rule, ",")
+	for i, segment := range segments {
+		if expanded, ok := p.aliases[strings.TrimSpace(segment)]; ok {
+			segments[i] = expanded
+		}
+	}
+	return strings.Join(segments, ",")
+}
+
+// aliasProblems reports the aliases that an expanded rule still contains; they
+// are combined with other tags through '|'.
+func (p *Plugin) aliasProblems(rule string) []string {
+	var problems []string
+	for _, segment := range strings.Split(rule, ",") {
+		alternatives := strings.Split(segment, "|")
+		if len(alternatives) < 2 {
+			continue
+		}
+		for _, alternative := range alternatives {
+			if name := strings.TrimSpace(alternative); p.aliases[name] != "" {
+				problems = append(problems, fmt.Sprintf("alias %q cannot be combined with other tags using '|'", name))
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,134 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// defaultDirectiveName identifies the directive filling omitted input fields
+// before they are validated.
+const defaultDirectiveName = "validateDefault"
+
+// builtinDefaults are the defaults implemented by the runtime.
+var builtinDefaults = set{"now": {}, "empty": {}}
+
+// scalarDefault is a built-in default on a custom scalar. Whether the default
+// fits depends on the Go type of the scalar, which MutateConfig checks once the
+// models are bound.
+type scalarDefault struct {
+	owner  string
+	scalar string
+	name   string
+	pos    *ast.Position
+}
+
+// readDefault reads the name of the @validateDefault applied to an input field,
+// or "" when there is none. Unknown names, non-null fields, which are always
+// set, fields with a GraphQL default value and built-in defaults that do not fit
+// the field type are returned as problems.
+func (p *Plugin) readDefault(schema *ast.Schema, owner string, field *ast.FieldDefinition) (string, []ruleError, error) {
+	defaults := field.Directives.ForNames(defaultDirectiveName)
+	if len(defaults) == 0 {
+		return "", nil, nil
+	}
+	if len(defaults) > 1 {
+		return "", nil, fmt.Errorf("@%s may only be applied once per field (%s)", defaultDirectiveName, owner)
+	}
+
+	arg := defaults[0].Arguments.ForName("name")
+	name, err := getArgumentValueAsString(arg)
+	if err != nil {
+		return "", nil, fmt.Errorf("@%s on %s requires a name", defaultDirectiveName, owner)
+	}
+
+	var problems []ruleError
+	if !p.defaults.contains(name) {
+		problems = append(problems, ruleError{pos: arg.Value.Position, message: fmt.Sprintf(
+			"@%s on %s: unknown default %q", defaultDirectiveName, owner, name)})
+	}
+	if field.Type.NonNull {
+		problems = append(problems, ruleError{pos: defaults[0].Position, message: fmt.Sprintf(
+			"@%s on %s: non-null fields are always set (got %s)", defaultDirectiveName, owner, field.Type.String())})
+	}
+	if field.DefaultValue != nil {
+		problems = append(problems, ruleError{pos: defaults[0].Position, message: fmt.Sprintf(
+			"@%s on %s cannot be combined with the default value %s", defaultDirectiveName, owner, field.DefaultValue.String())})
+	}
+	if builtinDefaults.contains(name) {
+		problems = append(problems, p.checkDefaultType(schema, owner, name, field.Type, arg.Value.Position)...)
+	}
+	return name, problems, nil
+}
+
+// checkDefaultType verifies that the built-in default name can fill a field of
+// typ: now needs a scalar bound to time.Time and empty a list, an input object
+// or a scalar bound to a Go slice or map. Custom scalars are recorded for
+// checkScalarDefaults.
+func (p *Plugin) checkDefaultType(schema *ast.Schema, owner, name string, typ *ast.Type, pos *ast.Position) []ruleError {
+	kind := ast.DefinitionKind("")
+	if def := schema.Types[typ.Name()]; def != nil {
+		kind = def.Kind
+	}
+
+	switch {
+	case name == "empty" && (typ.Elem != nil || kind == ast.InputObject):
+		return nil
+	case typ.Elem == nil && kind == ast.Scalar && !builtinScalars.contains(typ.Name()):
+		p.scalarDefaults = append(p.scalarDefaults, scalarDefault{owner: owner, scalar: typ.Name(), name: name, pos: pos})
+		return nil
+	}
+	return []ruleError{{pos: pos, message: fmt.Sprintf("@%s on %s: %s (got %s)", defaultDirectiveName, owner, defaultRequirement(name), typ.String())}}
+}
+
+// checkScalarDefaults verifies that the custom scalars carrying built-in
+// defaults are bound to a Go type the default can produce.
+func (p *Plugin) checkScalarDefaults(cfg *config.Config) error {
+	if len(p.scalarDefaults) == 0 {
+		return nil
+	}
+
+	binder := cfg.NewBinder()
+	var problems []ruleError
+	for _, entry := range p.scalarDefaults {
+		problem := func(format string, args ...any) {
+			problems = append(problems, ruleError{pos: entry.pos, message: fmt.Sprintf("@%s on %s: ", defaultDirectiveName, entry.owner) + fmt.Sprintf(format, args...)})
+		}
+
+		ref, err := binder.TypeReference(ast.NamedType(entry.scalar, nil), nil)
+		if err != nil {
+			problem("cannot resolve the Go type of %s: %v", entry.scalar, err)
+			continue
+		}
+		fits := hasElements(ref.GO)
+		if entry.name == "now" {
+			fits = isTime(ref.GO)
+		}
+		if !fits {
+			problem("%s (%s is bound to %s)", defaultRequirement(entry.name), entry.scalar, ref.GO.String())
+		}
+	}
+	if len(problems) > 0 {
+		return joinRuleErrors(problems)
+	}
+	return nil
+}
+
+// defaultRequirement describes the field types a built-in default can fill.
+func defaultRequirement(name string) string {
+	if name == "now" {
+		return "now requires a scalar bound to time.Time"
+	}
+	return "empty requires a list, an input object or a scalar bound to a Go slice or map"
+}
+
+// isTime reports whether typ, or what it points to, is time.Time.
+func isTime(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
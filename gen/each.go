@@ -0,0 +1,198 @@
+package gen
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// builtinScalars are the GraphQL scalars that never hold a list or a map.
+var builtinScalars = set{"String": {}, "Int": {}, "Float": {}, "Boolean": {}, "ID": {}}
+
+// eachRule is an element rule read from the each argument of @validate, with
+// the position of its value.
+type eachRule struct {
+	rule string
+	pos  *ast.Position
+}
+
+// scalarEach is an each argument on a custom scalar. Whether the scalar holds
+// elements depends on its Go type, which MutateConfig checks once the models
+// are bound.
+type scalarEach struct {
+	owner  string
+	scalar string
+	pos    *ast.Position
+}
+
+// readEach reads the each argument of a @validate directive. A single string is
+// coerced to a list of one, as GraphQL does for list arguments.
+func readEach(owner string, arg *ast.Argument) ([]eachRule, error) {
+	if arg == nil || arg.Value == nil {
+		return nil, nil
+	}
+
+	var values []*ast.Value
+	switch arg.Value.Kind {
+	case ast.NullValue:
+		return nil, nil
+	case ast.ListValue:
+		for _, child := range arg.Value.Children {
+			values = append(values, child.Value)
+		}
+	default:
+		values = []*ast.Value{arg.Value}
+	}
+
+	out := make([]eachRule, 0, len(values))
+	for _, value := range values {
+		if value.Kind != ast.StringValue && value.Kind != ast.BlockValue {
+			return nil, fmt.Errorf("@%s on %s: each must be a string or a list of strings (got %s)", directiveName, owner, value.String())
+		}
+		out = append(out, eachRule{rule: value.Raw, pos: value.Position})
+	}
+	return out, nil
+}
+
+// listDepth returns how many levels of elements a value of typ has: the list
+// nesting, or one for custom scalars, which may be backed by a Go map or slice.
+// checkScalarEach rejects the scalars that are not.
+func listDepth(schema *ast.Schema, typ *ast.Type) int {
+	depth := 0
+	for typ != nil && typ.Elem != nil {
+		depth++
+		typ = typ.Elem
+	}
+	if depth > 0 || typ == nil || builtinScalars.contains(typ.NamedType) {
+		return depth
+	}
+	if def := schema.Types[typ.NamedType]; def != nil && def.Kind == ast.Scalar {
+		return 1
+	}
+	return 0
+}
+
+// checkEach verifies the element rules of owner, whose type has depth levels of
+// elements. rule is the rule of the value itself.
+func checkEach(owner, rule string, arg *ast.Argument, each []eachRule, depth int, typ *ast.Type, tags, siblings set) []ruleError {
+	if len(each) == 0 {
+		return nil
+	}
+
+	problem := func(pos *ast.Position, format string, args ...any) ruleError {
+		return ruleError{pos: pos, message: fmt.Sprintf("@%s on %s: ", directiveName, owner) + fmt.Sprintf(format, args...)}
+	}
+
+	pos := arg.Position
+	if arg.Value.Position != nil {
+		pos = arg.Value.Position
+	}
+
+	var problems []ruleError
+	switch {
+	case depth == 0:
+		return []ruleError{problem(pos, "each requires a list or a custom scalar (got %s)", typ.String())}
+	case len(each) > depth:
+		return []ruleError{problem(pos, "each lists %d rules but %s has %d level(s) of elements", len(each), typ.String(), depth)}
+	case ruleTags(rule).contains(diveTag):
+		problems = append(problems, problem(pos, "each cannot be combined with %q in rule", diveTag))
+	}
+
+	for _, entry := range each {
+		if ruleTags(entry.rule).contains(diveTag) {
+			problems = append(problems, problem(entry.pos, "each rules cannot use %q", diveTag))
+			continue
+		}
+		for _, p := range checkRule(entry.rule, tags, siblings) {
+			problems = append(problems, problem(entry.pos, "%s", p))
+		}
+	}
+	return problems
+}
+
+// diveRule appends the element rules to rule, one dive per level of elements.
+// The rules apply to the innermost levels, so a single rule on [[Int!]!] checks
+// the integers. Rules on nullable elements of typ are skipped for null elements,
+// unless they start with an omit tag or use required.
+func diveRule(rule string, each []eachRule, depth int, typ *ast.Type) string {
+	if len(each) == 0 {
+		return rule
+	}
+
+	var segments []string
+	if strings.TrimSpace(rule) != "" {
+		segments = append(segments, rule)
+	}
+	skip := depth - len(each)
+	for level := 0; level < depth; level++ {
+		segments = append(segments, diveTag)
+		if typ != nil {
+			typ = typ.Elem
+		}
+		if level < skip {
+			continue
+		}
+		element := each[level-skip].rule
+		if typ != nil && !typ.NonNull && !handlesNil(element) {
+			segments = append(segments, "omitnil")
+		}
+		segments = append(segments, element)
+	}
+	return strings.Join(segments, ",")
+}
+
+// handlesNil reports whether rule decides itself what happens to nil values:
+// it starts with an omit tag or requires the value.
+func handlesNil(rule string) bool {
+	first, _, _ := strings.Cut(strings.TrimSpace(rule), ",")
+	switch strings.TrimSpace(first) {
+	case "omitempty", "omitnil", "omitzero":
+		return true
+	}
+	return ruleTags(rule).contains("required")
+}
+
+// checkScalarEach verifies that the custom scalars carrying each rules are bound
+// to a Go slice, array or map, the only values the validator can dive into.
+func (p *Plugin) checkScalarEach(cfg *config.Config) error {
+	if len(p.scalarEach) == 0 {
+		return nil
+	}
+
+	binder := cfg.NewBinder()
+	var problems []ruleError
+	for _, entry := range p.scalarEach {
+		problem := func(format string, args ...any) {
+			problems = append(problems, ruleError{pos: entry.pos, message: fmt.Sprintf("@%s on %s: ", directiveName, entry.owner) + fmt.Sprintf(format, args...)})
+		}
+
+		ref, err := binder.TypeReference(ast.NamedType(entry.scalar, nil), nil)
+		if err != nil {
+			problem("cannot resolve the Go type of %s: %v", entry.scalar, err)
+			continue
+		}
+		if !hasElements(ref.GO) {
+			problem("each requires a list or a scalar bound to a Go slice or map (%s is bound to %s)", entry.scalar, ref.GO.String())
+		}
+	}
+	if len(problems) > 0 {
+		return joinRuleErrors(problems)
+	}
+	return nil
+}
+
+// hasElements reports whether values of typ, or of what it points to, are
+// slices, arrays or maps.
+func hasElements(typ types.Type) bool {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	switch typ.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Package statictest holds models shaped like gqlgen's generated ones. Its
+// validate_gen.go is produced by the static validation generator and checked
+// against go-playground/validator by the tests of this package.
+package statictest
+
+type Kind string
+
+type StaticInput struct {
+	Name     string         `json:"name" validate:"required,min=2,max=10"`
+	Nick     *string        `json:"nick,omitempty" validate:"omitempty,alphanum"`
+	Code     string         `json:"code" validate:"len=3|eq=none"`
+	Role     string         `json:"role" validate:"oneof=admin user 'power user'"`
+	Kind     Kind           `json:"kind" validate:"oneof=A B,min=1"`
+	Level    int            `json:"level" validate:"gte=1,lte=5"`
+	Rank     uint           `json:"rank" validate:"omitempty,oneof=1 2 -3"`
+	Score    *float64       `json:"score,omitempty" validate:"omitnil,gt=0.5,lt=10"`
+	Count    *int           `json:"count,omitempty" validate:"min=1"`
+	Tags     []string       `json:"tags,omitempty" validate:"omitempty,max=3,dive,required,hexadecimal"`
+	Matrix   [][]int        `json:"matrix,omitempty" validate:"dive,dive,ne=0"`
+	Email    string         `json:"email" validate:"omitempty,email"`
+	Phone    *string        `json:"phone,omitempty" validate:"required_without=Email,omitempty,e164"`
+	ID       string         `json:"id" validate:"omitempty,uuid4"`
+	Password string         `json:"password" validate:"required"`
+	Confirm  string         `json:"confirm" validate:"eqfield=Password"`
+	Hint     string         `json:"hint" validate:"nefield=Password,excluded_with_all=Nick Phone"`
+	Active   bool           `json:"active" validate:"eq=true"`
+	Nested   *NestedInput   `json:"nested,omitempty" validate:"required"`
+	Items    []*NestedInput `json:"items,omitempty" validate:"dive"`
+	Plain    PlainInput     `json:"plain"`
+	Numbers  []*string      `json:"numbers,omitempty" validate:"dive,omitnil,number|numeric"`
+	Lookup   map[string]int `json:"lookup,omitempty" validate:"omitempty,min=1"`
+	Choice   *ChoiceInput   `json:"choice,omitempty"`
+	Draft    *NestedInput   `json:"draft,omitempty" validate:"-"`
+}
+
+func (StaticInput) IsValidatable() {}
+
+type NestedInput struct {
+	Value string `json:"value" validate:"required,numeric"`
+	UUID  string `json:"uuid" validate:"required_with=Value,omitempty,uuid"`
+}
+
+func (NestedInput) IsValidatable() {}
+
+// ChoiceInput is a OneOf input object.
+type ChoiceInput struct {
+	ID   *string  `json:"id,omitempty"`
+	Text *string  `json:"text,omitempty" validate:"omitnil,min=2"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+func (ChoiceInput) IsValidatable() {}
+
+type PlainInput struct {
+	Note string `json:"note"`
+}
+
+// DynamicInput uses a tag the generator does not support.
+type DynamicInput struct {
+	Website string `json:"website" validate:"url"`
+}
+
+func (DynamicInput) IsValidatable() {}
+
+// WrapperInput contains a type that is validated with reflection.
+type WrapperInput struct {
+	Name  string       `json:"name" validate:"required"`
+	Inner DynamicInput `json:"inner"`
+}
+
+func (WrapperInput) IsValidatable() {}
@@ -0,0 +1,207 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package statictest
+
+import (
+	"context"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/danutavadanei/gqlgen-validate/runtime"
+	validator "github.com/go-playground/validator/v10"
+)
+
+// Validate checks the @validate rules of ChoiceInput without reflection and
+// returns validator.ValidationErrors when they fail.
+func (m ChoiceInput) Validate(ctx context.Context) error {
+	if errs := m.validateFields("ChoiceInput", "ChoiceInput"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (m ChoiceInput) validateFields(ns, sns string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if m.Text != nil {
+		if utf8.RuneCountInString(*m.Text) < 2 {
+			errs = append(errs, runtime.NewFieldError("min", "2", ns+".text", sns+".Text", *m.Text))
+		}
+	}
+	{
+		set := 0
+		if m.ID != nil {
+			set++
+		}
+		if m.Text != nil {
+			set++
+		}
+		if m.Tags != nil {
+			set++
+		}
+		if set != 1 {
+			errs = append(errs, runtime.NewFieldError("oneOf", "id text tags", ns+".", sns+".", m))
+		}
+	}
+	return errs
+}
+
+// Validate checks the @validate rules of NestedInput without reflection and
+// returns validator.ValidationErrors when they fail.
+func (m NestedInput) Validate(ctx context.Context) error {
+	if errs := m.validateFields("NestedInput", "NestedInput"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (m NestedInput) validateFields(ns, sns string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if m.Value == "" {
+		errs = append(errs, runtime.NewFieldError("required", "", ns+".value", sns+".Value", m.Value))
+	} else if !runtime.MatchFormat("numeric", m.Value) {
+		errs = append(errs, runtime.NewFieldError("numeric", "", ns+".value", sns+".Value", m.Value))
+	}
+	if m.Value != "" && m.UUID == "" {
+		errs = append(errs, runtime.NewFieldError("required_with", "Value", ns+".uuid", sns+".UUID", m.UUID))
+	} else if m.UUID != "" {
+		if !runtime.MatchFormat("uuid", m.UUID) {
+			errs = append(errs, runtime.NewFieldError("uuid", "", ns+".uuid", sns+".UUID", m.UUID))
+		}
+	}
+	return errs
+}
+
+// Validate checks the @validate rules of StaticInput without reflection and
+// returns validator.ValidationErrors when they fail.
+func (m StaticInput) Validate(ctx context.Context) error {
+	if errs := m.validateFields("StaticInput", "StaticInput"); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (m StaticInput) validateFields(ns, sns string) validator.ValidationErrors {
+	var errs validator.ValidationErrors
+	if m.Name == "" {
+		errs = append(errs, runtime.NewFieldError("required", "", ns+".name", sns+".Name", m.Name))
+	} else if utf8.RuneCountInString(m.Name) < 2 {
+		errs = append(errs, runtime.NewFieldError("min", "2", ns+".name", sns+".Name", m.Name))
+	} else if utf8.RuneCountInString(m.Name) > 10 {
+		errs = append(errs, runtime.NewFieldError("max", "10", ns+".name", sns+".Name", m.Name))
+	}
+	if m.Nick != nil {
+		if !runtime.MatchFormat("alphanum", *m.Nick) {
+			errs = append(errs, runtime.NewFieldError("alphanum", "", ns+".nick", sns+".Nick", *m.Nick))
+		}
+	}
+	if utf8.RuneCountInString(m.Code) != 3 && m.Code != "none" {
+		errs = append(errs, runtime.NewFieldError("len=3|eq=none", "none", ns+".code", sns+".Code", m.Code))
+	}
+	if m.Role != "admin" && m.Role != "user" && m.Role != "power user" {
+		errs = append(errs, runtime.NewFieldError("oneof", "admin user 'power user'", ns+".role", sns+".Role", m.Role))
+	}
+	if m.Kind != "A" && m.Kind != "B" {
+		errs = append(errs, runtime.NewFieldError("oneof", "A B", ns+".kind", sns+".Kind", m.Kind))
+	} else if utf8.RuneCountInString(string(m.Kind)) < 1 {
+		errs = append(errs, runtime.NewFieldError("min", "1", ns+".kind", sns+".Kind", m.Kind))
+	}
+	if m.Level < 1 {
+		errs = append(errs, runtime.NewFieldError("gte", "1", ns+".level", sns+".Level", m.Level))
+	} else if m.Level > 5 {
+		errs = append(errs, runtime.NewFieldError("lte", "5", ns+".level", sns+".Level", m.Level))
+	}
+	if m.Rank != 0 {
+		if m.Rank != 1 && m.Rank != 2 {
+			errs = append(errs, runtime.NewFieldError("oneof", "1 2 -3", ns+".rank", sns+".Rank", m.Rank))
+		}
+	}
+	if m.Score != nil {
+		if *m.Score <= 0.5 {
+			errs = append(errs, runtime.NewFieldError("gt", "0.5", ns+".score", sns+".Score", *m.Score))
+		} else if *m.Score >= 10 {
+			errs = append(errs, runtime.NewFieldError("lt", "10", ns+".score", sns+".Score", *m.Score))
+		}
+	}
+	if m.Count == nil {
+		errs = append(errs, runtime.NewFieldError("min", "1", ns+".count", sns+".Count", m.Count))
+	} else if *m.Count < 1 {
+		errs = append(errs, runtime.NewFieldError("min", "1", ns+".count", sns+".Count", *m.Count))
+	}
+	if m.Tags != nil {
+		if len(m.Tags) > 3 {
+			errs = append(errs, runtime.NewFieldError("max", "3", ns+".tags", sns+".Tags", m.Tags))
+		} else {
+			for i1, e1 := range m.Tags {
+				if e1 == "" {
+					errs = append(errs, runtime.NewFieldError("required", "", ns+".tags["+strconv.Itoa(i1)+"]", sns+".Tags["+strconv.Itoa(i1)+"]", e1))
+				} else if !runtime.MatchFormat("hexadecimal", e1) {
+					errs = append(errs, runtime.NewFieldError("hexadecimal", "", ns+".tags["+strconv.Itoa(i1)+"]", sns+".Tags["+strconv.Itoa(i1)+"]", e1))
+				}
+			}
+		}
+	}
+	for i2, e2 := range m.Matrix {
+		for i3, e3 := range e2 {
+			if e3 == 0 {
+				errs = append(errs, runtime.NewFieldError("ne", "0", ns+".matrix["+strconv.Itoa(i2)+"]["+strconv.Itoa(i3)+"]", sns+".Matrix["+strconv.Itoa(i2)+"]["+strconv.Itoa(i3)+"]", e3))
+			}
+		}
+	}
+	if m.Email != "" {
+		if !runtime.MatchFormat("email", m.Email) {
+			errs = append(errs, runtime.NewFieldError("email", "", ns+".email", sns+".Email", m.Email))
+		}
+	}
+	if m.Phone == nil {
+		if m.Email == "" {
+			errs = append(errs, runtime.NewFieldError("required_without", "Email", ns+".phone", sns+".Phone", m.Phone))
+		}
+	} else if !runtime.MatchFormat("e164", *m.Phone) {
+		errs = append(errs, runtime.NewFieldError("e164", "", ns+".phone", sns+".Phone", *m.Phone))
+	}
+	if m.ID != "" {
+		if !runtime.MatchFormat("uuid4", m.ID) {
+			errs = append(errs, runtime.NewFieldError("uuid4", "", ns+".id", sns+".ID", m.ID))
+		}
+	}
+	if m.Password == "" {
+		errs = append(errs, runtime.NewFieldError("required", "", ns+".password", sns+".Password", m.Password))
+	}
+	if m.Confirm != m.Password {
+		errs = append(errs, runtime.NewFieldError("eqfield", "Password", ns+".confirm", sns+".Confirm", m.Confirm))
+	}
+	if m.Hint == m.Password {
+		errs = append(errs, runtime.NewFieldError("nefield", "Password", ns+".hint", sns+".Hint", m.Hint))
+	} else if m.Nick != nil && m.Phone != nil && m.Hint != "" {
+		errs = append(errs, runtime.NewFieldError("excluded_with_all", "Nick Phone", ns+".hint", sns+".Hint", m.Hint))
+	}
+	if !m.Active {
+		errs = append(errs, runtime.NewFieldError("eq", "true", ns+".active", sns+".Active", m.Active))
+	}
+	if m.Nested == nil {
+		errs = append(errs, runtime.NewFieldError("required", "", ns+".nested", sns+".Nested", m.Nested))
+	} else {
+		errs = append(errs, m.Nested.validateFields(ns+".nested", sns+".Nested")...)
+	}
+	for i4, e4 := range m.Items {
+		if e4 != nil {
+			errs = append(errs, e4.validateFields(ns+".items["+strconv.Itoa(i4)+"]", sns+".Items["+strconv.Itoa(i4)+"]")...)
+		}
+	}
+	for i5, e5 := range m.Numbers {
+		if e5 != nil {
+			if !runtime.MatchFormat("number", *e5) && !runtime.MatchFormat("numeric", *e5) {
+				errs = append(errs, runtime.NewFieldError("number|numeric", "", ns+".numbers["+strconv.Itoa(i5)+"]", sns+".Numbers["+strconv.Itoa(i5)+"]", *e5))
+			}
+		}
+	}
+	if m.Lookup != nil {
+		if len(m.Lookup) < 1 {
+			errs = append(errs, runtime.NewFieldError("min", "1", ns+".lookup", sns+".Lookup", m.Lookup))
+		}
+	}
+	if m.Choice != nil {
+		errs = append(errs, m.Choice.validateFields(ns+".choice", sns+".Choice")...)
+	}
+	return errs
+}
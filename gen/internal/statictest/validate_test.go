@@ -0,0 +1,164 @@
+package statictest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fieldError holds the parts of a validator.FieldError the runtime relies on.
+type fieldError struct {
+	Tag, Param, Namespace, StructNamespace, Field, StructField string
+	Value                                                      any
+}
+
+func TestGeneratedMatchesValidator(t *testing.T) {
+	engine := newEngine()
+
+	tests := map[string]func(in *StaticInput){
+		"valid":                    func(*StaticInput) {},
+		"zero value":               func(in *StaticInput) { *in = StaticInput{} },
+		"short name":               func(in *StaticInput) { in.Name = "é" },
+		"long name":                func(in *StaticInput) { in.Name = "ééééééééééé" },
+		"unicode name":             func(in *StaticInput) { in.Name = "éééééééééé" },
+		"invalid nick":             func(in *StaticInput) { in.Nick = ptr("not ok") },
+		"empty nick":               func(in *StaticInput) { in.Nick = ptr("") },
+		"code alternative":         func(in *StaticInput) { in.Code = "none" },
+		"invalid code":             func(in *StaticInput) { in.Code = "toolong" },
+		"quoted role":              func(in *StaticInput) { in.Role = "power user" },
+		"invalid role":             func(in *StaticInput) { in.Role = "power" },
+		"invalid kind":             func(in *StaticInput) { in.Kind = "C" },
+		"level too low":            func(in *StaticInput) { in.Level = 0 },
+		"level too high":           func(in *StaticInput) { in.Level = 6 },
+		"valid rank":               func(in *StaticInput) { in.Rank = 2 },
+		"invalid rank":             func(in *StaticInput) { in.Rank = 3 },
+		"score too low":            func(in *StaticInput) { in.Score = ptr(0.5) },
+		"score too high":           func(in *StaticInput) { in.Score = ptr(10.0) },
+		"nil count":                func(in *StaticInput) { in.Count = nil },
+		"count too low":            func(in *StaticInput) { in.Count = ptr(0) },
+		"empty tags":               func(in *StaticInput) { in.Tags = []string{} },
+		"too many tags":            func(in *StaticInput) { in.Tags = []string{"a", "b", "c", "d"} },
+		"invalid tags":             func(in *StaticInput) { in.Tags = []string{"", "0xff", "zz"} },
+		"zero in matrix":           func(in *StaticInput) { in.Matrix = [][]int{{1, 0}, nil, {0}} },
+		"invalid email":            func(in *StaticInput) { in.Email = "not-an-email" },
+		"named email":              func(in *StaticInput) { in.Email = "Ada <ada@example.com>" },
+		"without email and phone":  func(in *StaticInput) { in.Email, in.Phone = "", nil },
+		"phone without email":      func(in *StaticInput) { in.Email, in.Phone = "", ptr("+14155552671") },
+		"invalid phone":            func(in *StaticInput) { in.Phone = ptr("555") },
+		"invalid id":               func(in *StaticInput) { in.ID = "9b2c3d4e-1f2a-1b3c-8d4e-5f6a7b8c9d0e" },
+		"mismatched confirm":       func(in *StaticInput) { in.Confirm = "other" },
+		"hint equals password":     func(in *StaticInput) { in.Hint = in.Password },
+		"hint with nick and phone": func(in *StaticInput) { in.Nick, in.Phone = ptr("ada"), ptr("+14155552671") },
+		"inactive":                 func(in *StaticInput) { in.Active = false },
+		"nil nested":               func(in *StaticInput) { in.Nested = nil },
+		"invalid nested":           func(in *StaticInput) { in.Nested = &NestedInput{Value: "x", UUID: "y"} },
+		"nested without uuid":      func(in *StaticInput) { in.Nested = &NestedInput{Value: "1"} },
+		"invalid items": func(in *StaticInput) {
+			in.Items = []*NestedInput{nil, {Value: "1.5"}, {UUID: "nope"}}
+		},
+		"invalid numbers": func(in *StaticInput) { in.Numbers = []*string{nil, ptr("-1.5"), ptr("x"), ptr("")} },
+		"empty lookup":    func(in *StaticInput) { in.Lookup = map[string]int{} },
+		"empty choice":    func(in *StaticInput) { in.Choice = &ChoiceInput{} },
+		"valid choice":    func(in *StaticInput) { in.Choice = &ChoiceInput{Tags: []string{}} },
+		"two choices":     func(in *StaticInput) { in.Choice = &ChoiceInput{ID: ptr("1"), Text: ptr("")} },
+		"skipped draft":   func(in *StaticInput) { in.Draft = &NestedInput{Value: "x"} },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			in := validInput()
+			mutate(&in)
+
+			expected := fieldErrors(t, engine.Struct(in))
+			actual := fieldErrors(t, in.Validate(context.Background()))
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestGeneratedNotForDynamicTypes(t *testing.T) {
+	_, ok := any(DynamicInput{}).(interface{ Validate(context.Context) error })
+	assert.False(t, ok)
+
+	_, ok = any(WrapperInput{}).(interface{ Validate(context.Context) error })
+	assert.False(t, ok)
+}
+
+func validInput() StaticInput {
+	return StaticInput{
+		Name:     "Ada",
+		Code:     "abc",
+		Role:     "admin",
+		Kind:     "A",
+		Level:    3,
+		Count:    ptr(1),
+		Email:    "ada@example.com",
+		ID:       "9b2c3d4e-1f2a-4b3c-8d4e-5f6a7b8c9d0e",
+		Password: "secret",
+		Confirm:  "secret",
+		Hint:     "hint",
+		Active:   true,
+		Nested:   &NestedInput{Value: "42", UUID: "9b2c3d4e-1f2a-4b3c-8d4e-5f6a7b8c9d0e"},
+	}
+}
+
+func newEngine() *validator.Validate {
+	engine := validator.New(validator.WithRequiredStructEnabled())
+	engine.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name, _, _ := strings.Cut(fld.Tag.Get("json"), ",")
+		return name
+	})
+	// The oneOf struct rule as registered by the runtime for ChoiceInput.
+	engine.RegisterStructValidation(func(sl validator.StructLevel) {
+		choice := sl.Current().Interface().(ChoiceInput)
+		set := 0
+		if choice.ID != nil {
+			set++
+		}
+		if choice.Text != nil {
+			set++
+		}
+		if choice.Tags != nil {
+			set++
+		}
+		if set != 1 {
+			sl.ReportError(sl.Current().Interface(), "", "", "oneOf", "id text tags")
+		}
+	}, ChoiceInput{})
+	return engine
+}
+
+func fieldErrors(t *testing.T, err error) []fieldError {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var errs validator.ValidationErrors
+	require.True(t, errors.As(err, &errs), "unexpected error %v", err)
+
+	out := make([]fieldError, 0, len(errs))
+	for _, fe := range errs {
+		out = append(out, fieldError{
+			Tag:             fe.Tag(),
+			Param:           fe.Param(),
+			Namespace:       fe.Namespace(),
+			StructNamespace: fe.StructNamespace(),
+			Field:           fe.Field(),
+			StructField:     fe.StructField(),
+			Value:           fe.Value(),
+		})
+	}
+	return out
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -77,21 +77,183 @@ func (s set) values() []string {
 
 // Plugin is a gqlgen plugin that wires validation rules into generated models.
 type Plugin struct {
-	markerTypes set
+	markerTypes    set
+	arguments      []argumentRule
+	results        []argumentRule
+	skips          []skipRule
+	tags           set
+	fieldRules     map[string]map[string]string
+	structRules    map[string][]structRule
+	structTags     set
+	aliases        map[string]string
+	scalarRules    map[string]string
+	transforms     set
+	defaults       set
+	scalarEach     []scalarEach
+	scalarDefaults []scalarDefault
+	sources        []*ast.Source
+	static         bool
 }
 
-// New constructs the plugin instance.
-func New() plugin.Plugin {
-	return &Plugin{
+// argumentRule is a @validate directive found on a resolver argument or, with
+// an empty Argument, on an output field. Arguments carrying only
+// @validateTransform have an empty Rule.
+type argumentRule struct {
+	Object     string
+	Field      string
+	Argument   string
+	Rule       string
+	Message    string
+	MessageKey string
+	Messages   []customMessage
+	Transforms []string
+}
+
+// Name returns the name the runtime knows the rule by: the argument or, for
+// output fields, the field.
+func (r argumentRule) Name() string {
+	if r.Argument != "" {
+		return r.Argument
+	}
+	return r.Field
+}
+
+// goTags returns the goTag directives carrying the rule and its messages.
+func (r argumentRule) goTags() ast.DirectiveList {
+	tags := ast.DirectiveList{newGoTagDirective("validate", r.Rule)}
+	if r.Message != "" {
+		tags = append(tags, newGoTagDirective("message", r.Message))
+	}
+	if r.MessageKey != "" {
+		tags = append(tags, newGoTagDirective("messageKey", r.MessageKey))
+	}
+	for _, message := range r.Messages {
+		tags = append(tags, newGoTagDirective(message.tagKey(), message.Text))
+	}
+	return tags
+}
+
+// customMessage is a custom message for a single rule tag, locale or both.
+type customMessage struct {
+	Rule   string
+	Locale string
+	Text   string
+}
+
+// tagKey returns the struct tag carrying the message: message_<rule>-<locale>,
+// with the rule or locale part left out when unset.
+func (m customMessage) tagKey() string {
+	key := "message"
+	if m.Rule != "" {
+		key += "_" + m.Rule
+	}
+	if m.Locale != "" {
+		key += "-" + m.Locale
+	}
+	return key
+}
+
+// Option configures the plugin.
+type Option func(*Plugin)
+
+// WithCustomTags makes the rule checker accept tags that are registered with the
+// validator at runtime, such as custom validations (runtime.WithValidation) and
+// aliases (runtime.WithAlias).
+func WithCustomTags(tags ...string) Option {
+	return func(p *Plugin) {
+		for _, tag := range tags {
+			p.tags.add(tag)
+		}
+	}
+}
+
+// WithStructRules makes the rule checker accept struct rules that are registered
+// with the runtime (runtime.WithStructRule) for use in @validateStruct.
+func WithStructRules(names ...string) Option {
+	return func(p *Plugin) {
+		for _, name := range names {
+			p.structTags.add(name)
+		}
+	}
+}
+
+// WithCustomTransforms makes the plugin accept @validateTransform operations
+// that are registered with the runtime (runtime.WithTransform).
+func WithCustomTransforms(names ...string) Option {
+	return func(p *Plugin) {
+		for _, name := range names {
+			p.transforms.add(name)
+		}
+	}
+}
+
+// WithCustomDefaults makes the plugin accept @validateDefault names that are
+// registered with the runtime (runtime.WithDefault).
+func WithCustomDefaults(names ...string) Option {
+	return func(p *Plugin) {
+		for _, name := range names {
+			p.defaults.add(name)
+		}
+	}
+}
+
+// WithAliases declares named rules, e.g. "emailAddress": "required,email,max=254",
+// in addition to the @validateAlias directives of the schema. The plugin expands
+// aliases into their rules, so nothing needs to be registered at runtime.
+func WithAliases(aliases map[string]string) Option {
+	return func(p *Plugin) {
+		maps.Copy(p.aliases, aliases)
+	}
+}
+
+// WithScalarRules sets default rules for scalars, e.g. "Email": "email" or
+// "ID": "uuid4". Input fields of those scalars, including lists of them, get
+// the rule unless they carry a @validate directive of their own.
+func WithScalarRules(rules map[string]string) Option {
+	return func(p *Plugin) {
+		maps.Copy(p.scalarRules, rules)
+	}
+}
+
+// WithStaticValidation generates a Validate(ctx) method for every validated
+// input type whose rules only use common tags (see README). The runtime calls it
+// instead of go-playground's reflection; other types keep the reflective path.
+func WithStaticValidation() Option {
+	return func(p *Plugin) {
+		p.static = true
+	}
+}
+
+// New constructs the plugin instance. Added with api.AddPlugin it does not see
+// the schema sources and declares no directives; use AddPlugin instead unless
+// the schema declares them itself.
+func New(opts ...Option) plugin.Plugin {
+	p := &Plugin{
 		markerTypes: make(set),
+		tags:        maps.Clone(builtinTags),
+		fieldRules:  make(map[string]map[string]string),
+		structRules: make(map[string][]structRule),
+		structTags:  maps.Clone(builtinStructRules),
+		aliases:     make(map[string]string),
+		scalarRules: make(map[string]string),
+		transforms:  maps.Clone(builtinTransforms),
+		defaults:    maps.Clone(builtinDefaults),
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
+	return p
 }
 
 // Name implements plugin.Plugin.
 func (p *Plugin) Name() string { return "gqlgen-validate" }
 
 // MutateSchema ensures directives exist and rewrites fields with validation metadata.
+// Every rule is checked against the validator tag grammar; all problems are
+// reported together with their schema positions.
 func (p *Plugin) MutateSchema(schema *ast.Schema) error {
+	var problems []ruleError
+
 	// Ensure goTag directive exists (used to inject struct tags).
 	if _, ok := schema.Directives[goTagDirectiveName]; !ok {
 		schema.Directives[goTagDirectiveName] = &ast.DirectiveDefinition{
@@ -99,7 +261,32 @@ func (p *Plugin) MutateSchema(schema *ast.Schema) error {
 		}
 	}
 
+	aliasProblems, err := p.collectAliases(schema)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, aliasProblems...)
+	problems = append(problems, p.checkScalarRules(schema)...)
+
 	for typeName, def := range schema.Types {
+		if def.Kind == ast.Object {
+			argProblems, err := p.collectArguments(schema, def)
+			if err != nil {
+				return err
+			}
+			problems = append(problems, argProblems...)
+
+			resultProblems, err := p.collectResults(schema, def)
+			if err != nil {
+				return err
+			}
+			problems = append(problems, resultProblems...)
+
+			if err := p.collectSkips(def); err != nil {
+				return err
+			}
+			continue
+		}
 		if def.Kind != ast.InputObject {
 			continue
 		}
@@ -108,30 +295,74 @@ func (p *Plugin) MutateSchema(schema *ast.Schema) error {
 			return fmt.Errorf("@%s may only be applied to input fields (found on %s)", directiveName, def.Name)
 		}
 
-		hasValidateDirectives := false
+		siblings := make(set, len(def.Fields))
+		for _, field := range def.Fields {
+			siblings.add(field.Name)
+		}
+
+		structProblems, err := p.collectStructRules(def, siblings)
+		if err != nil {
+			return err
+		}
+		problems = append(problems, structProblems...)
+		hasValidateDirectives := len(p.structRules[def.Name]) > 0
 
 		for _, field := range def.Fields {
+			transforms, transformErrs, err := p.readTransforms(schema, def.Name+"."+field.Name, field.Directives, field.Type)
+			if err != nil {
+				return err
+			}
+			problems = append(problems, transformErrs...)
+			if len(transforms) > 0 {
+				field.Directives = append(field.Directives, newGoTagDirective("transform", strings.Join(transforms, ",")))
+			}
+
+			name, defaultErrs, err := p.readDefault(schema, def.Name+"."+field.Name, field)
+			if err != nil {
+				return err
+			}
+			problems = append(problems, defaultErrs...)
+			if name != "" {
+				field.Directives = append(field.Directives, newGoTagDirective("default", name))
+			}
+
+			skip, err := hasSkip(def.Name+"."+field.Name, field.Directives)
+			if err != nil {
+				return err
+			}
+			if skip {
+				// Nested inputs are validated by default; other values only had
+				// their scalar default to drop.
+				if nested := schema.Types[field.Type.Name()]; nested != nil && nested.Kind == ast.InputObject {
+					field.Directives = append(field.Directives, newGoTagDirective("validate", skipTag))
+					p.setFieldRule(def.Name, field.Name, skipTag)
+				}
+				continue
+			}
+
 			validateDirectives := field.Directives.ForNames(directiveName)
 			if len(validateDirectives) == 0 {
+				if rule, ok := p.scalarRule(field.Type); ok {
+					hasValidateDirectives = true
+					field.Directives = append(field.Directives, newGoTagDirective("validate", rule))
+					p.setFieldRule(def.Name, field.Name, rule)
+				}
 				continue
 			}
 			if len(validateDirectives) > 1 {
 				return fmt.Errorf("@%s may only be applied once per field (%s.%s)", directiveName, def.Name, field.Name)
 			}
 
-			validate := validateDirectives[0]
 			hasValidateDirectives = true
 
-			rule, err := getArgumentValueAsString(validate.Arguments.ForName("rule"))
+			rule, ruleErrs, err := p.readRule(schema, def.Name+"."+field.Name, validateDirectives[0], field.Type, siblings)
 			if err != nil {
-				return fmt.Errorf("@%s on %s.%s requires a rule", directiveName, def.Name, field.Name)
+				return err
 			}
+			problems = append(problems, ruleErrs...)
+			field.Directives = append(field.Directives, rule.goTags()...)
 
-			field.Directives = append(field.Directives, newGoTagDirective("validate", toGoRuleParams(rule)))
-
-			if message, err := getArgumentValueAsString(validate.Arguments.ForName("message")); err == nil {
-				field.Directives = append(field.Directives, newGoTagDirective("message", message))
-			}
+			p.setFieldRule(def.Name, field.Name, rule.Rule)
 		}
 
 		if hasValidateDirectives {
@@ -139,38 +370,225 @@ func (p *Plugin) MutateSchema(schema *ast.Schema) error {
 		}
 	}
 
+	if len(problems) > 0 {
+		return joinRuleErrors(problems)
+	}
+
 	return nil
 }
 
+// setFieldRule records the rule of an input field for the static generator.
+func (p *Plugin) setFieldRule(object, field, rule string) {
+	if p.fieldRules[object] == nil {
+		p.fieldRules[object] = make(map[string]string)
+	}
+	p.fieldRules[object][field] = rule
+}
+
+// collectArguments records the @validate rules and @validateTransform
+// operations declared on the arguments of the object's fields so the runtime
+// can apply them. Problems found in the rules are returned separately from
+// structural errors.
+func (p *Plugin) collectArguments(schema *ast.Schema, def *ast.Definition) ([]ruleError, error) {
+	if def.BuiltIn {
+		return nil, nil
+	}
+
+	var problems []ruleError
+
+	for _, field := range def.Fields {
+		for _, arg := range field.Arguments {
+			owner := def.Name + "." + field.Name + "." + arg.Name
+			transforms, transformErrs, err := p.readTransforms(schema, owner, arg.Directives, arg.Type)
+			if err != nil {
+				return nil, err
+			}
+			problems = append(problems, transformErrs...)
+
+			validateDirectives := arg.Directives.ForNames(directiveName)
+			if len(validateDirectives) > 1 {
+				return nil, fmt.Errorf("@%s may only be applied once per argument (%s)", directiveName, owner)
+			}
+
+			var rule argumentRule
+			switch {
+			case len(validateDirectives) == 1:
+				var ruleErrs []ruleError
+				rule, ruleErrs, err = p.readRule(schema, owner, validateDirectives[0], arg.Type, nil)
+				if err != nil {
+					return nil, err
+				}
+				problems = append(problems, ruleErrs...)
+			case len(transforms) == 0:
+				continue
+			}
+
+			rule.Object, rule.Field, rule.Argument = def.Name, field.Name, arg.Name
+			rule.Transforms = transforms
+			p.arguments = append(p.arguments, rule)
+		}
+	}
+
+	return problems, nil
+}
+
+// collectResults records the @validate rules declared on the fields of an output
+// object. The runtime checks them against the resolved values, and the tags are
+// injected into the generated model as for input fields.
+func (p *Plugin) collectResults(schema *ast.Schema, def *ast.Definition) ([]ruleError, error) {
+	if def.BuiltIn {
+		return nil, nil
+	}
+
+	var problems []ruleError
+
+	for _, field := range def.Fields {
+		validateDirectives := field.Directives.ForNames(directiveName)
+		if len(validateDirectives) == 0 {
+			continue
+		}
+		if len(validateDirectives) > 1 {
+			return nil, fmt.Errorf("@%s may only be applied once per field (%s.%s)", directiveName, def.Name, field.Name)
+		}
+
+		rule, ruleErrs, err := p.readRule(schema, def.Name+"."+field.Name, validateDirectives[0], field.Type, nil)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, ruleErrs...)
+		field.Directives = append(field.Directives, rule.goTags()...)
+
+		rule.Object, rule.Field = def.Name, field.Name
+		p.results = append(p.results, rule)
+	}
+
+	return problems, nil
+}
+
+// readRule reads the rule and messages of a @validate directive applied to
+// owner, a value of type typ. Aliases are expanded and the element rules of its
+// each argument are merged into the rule with one dive per level of elements;
+// the rule itself may then be left out. Problems found in them are returned
+// separately from structural errors. siblings lists the fields that cross-field
+// rules may reference.
+func (p *Plugin) readRule(schema *ast.Schema, owner string, validate *ast.Directive, typ *ast.Type, siblings set) (argumentRule, []ruleError, error) {
+	var problems []ruleError
+
+	eachArg := validate.Arguments.ForName("each")
+	each, err := readEach(owner, eachArg)
+	if err != nil {
+		return argumentRule{}, nil, err
+	}
+	for i, entry := range each {
+		each[i].rule = p.expandAliases(entry.rule)
+		for _, problem := range p.aliasProblems(each[i].rule) {
+			problems = append(problems, ruleError{pos: entry.pos, message: fmt.Sprintf("@%s on %s: %s", directiveName, owner, problem)})
+		}
+	}
+
+	ruleArg := validate.Arguments.ForName("rule")
+	rule, err := getArgumentValueAsString(ruleArg)
+	if err != nil && (ruleArg != nil || len(each) == 0) {
+		return argumentRule{}, nil, fmt.Errorf("@%s on %s requires a rule", directiveName, owner)
+	}
+	if err == nil {
+		rule = p.expandAliases(rule)
+		problems = append(problems, ruleProblems(owner, ruleArg, rule, p.tags, siblings)...)
+		for _, problem := range p.aliasProblems(rule) {
+			problems = append(problems, ruleError{pos: ruleArg.Value.Position, message: fmt.Sprintf("@%s on %s: %s", directiveName, owner, problem)})
+		}
+	}
+	depth := listDepth(schema, typ)
+	problems = append(problems, checkEach(owner, rule, eachArg, each, depth, typ, p.tags, siblings)...)
+	if len(each) > 0 && depth > 0 && typ.Elem == nil {
+		p.scalarEach = append(p.scalarEach, scalarEach{owner: owner, scalar: typ.Name(), pos: eachArg.Value.Position})
+	}
+	rule = diveRule(rule, each, depth, typ)
+
+	messageArg := validate.Arguments.ForName("message")
+	message, err := getArgumentValueAsString(messageArg)
+	if err == nil {
+		problems = append(problems, messageProblems(owner, messageArg.Value, message)...)
+	}
+	messageKey, _ := getArgumentValueAsString(validate.Arguments.ForName("messageKey"))
+
+	messages, messageErrs, err := getMessages(owner, rule, validate.Arguments.ForName("messages"))
+	if err != nil {
+		return argumentRule{}, nil, err
+	}
+	problems = append(problems, messageErrs...)
+
+	return argumentRule{
+		Rule:       toGoRuleParams(rule),
+		Message:    message,
+		MessageKey: messageKey,
+		Messages:   messages,
+	}, problems, nil
+}
+
 // MutateConfig registers the directives so gqlgen does not expect runtime handlers.
+// It also checks the each rules of custom scalars against their bound Go types.
 func (p *Plugin) MutateConfig(cfg *config.Config) error {
+	if err := p.checkScalarEach(cfg); err != nil {
+		return err
+	}
+	if err := p.checkScalarDefaults(cfg); err != nil {
+		return err
+	}
+
 	if _, ok := cfg.Directives[goTagDirectiveName]; !ok {
 		cfg.Directives[goTagDirectiveName] = config.DirectiveConfig{
 			SkipRuntime: true,
 		}
 	}
-	if _, ok := cfg.Directives[directiveName]; !ok {
-		cfg.Directives[directiveName] = config.DirectiveConfig{
-			SkipRuntime: true,
+	for _, name := range []string{directiveName, structDirectiveName, aliasDirectiveName, skipDirectiveName, transformDirectiveName, defaultDirectiveName} {
+		if _, ok := cfg.Directives[name]; !ok {
+			cfg.Directives[name] = config.DirectiveConfig{
+				SkipRuntime: true,
+			}
 		}
 	}
 	return nil
 }
 
-// GenerateCode emits a small file that marks the validated input types.
+// GenerateCode emits a small file that marks the validated input types and
+// registers the struct rules, validated resolver arguments and output fields
+// and the skipped resolver fields with the runtime. With WithStaticValidation
+// it also writes the generated Validate methods.
 func (p *Plugin) GenerateCode(cfg *codegen.Data) error {
 	types := p.markerTypes.values()
 	sort.Strings(types)
 
+	if err := p.generateStatic(cfg); err != nil {
+		return err
+	}
+
 	filename := filepath.Join(filepath.Dir(cfg.Config.Model.Filename), "validatable_gen.go")
-	if len(types) == 0 {
+	if len(types) == 0 && len(p.arguments) == 0 && len(p.results) == 0 && len(p.skips) == 0 {
 		_ = os.Remove(filename)
 		return nil
 	}
 
+	structs := make([]structObject, 0, len(p.structRules))
+	for _, name := range types {
+		if rules := p.structRules[name]; len(rules) > 0 {
+			structs = append(structs, structObject{Object: name, Rules: rules})
+		}
+	}
+
 	data := struct {
-		Types []string
-	}{Types: types}
+		Types   []string
+		Fields  []argumentField
+		Results []resultObject
+		Structs []structObject
+		Skips   []skipRule
+	}{
+		Types:   types,
+		Fields:  groupArguments(p.arguments),
+		Results: groupResults(p.results),
+		Structs: structs,
+		Skips:   sortSkips(p.skips),
+	}
 
 	return templates.Render(templates.Options{
 		PackageName:     cfg.Config.Model.Package,
@@ -182,6 +600,60 @@ func (p *Plugin) GenerateCode(cfg *codegen.Data) error {
 	})
 }
 
+// argumentField groups the validated arguments of a single resolver field.
+type argumentField struct {
+	Object    string
+	Name      string
+	Arguments []argumentRule
+}
+
+// groupArguments groups the rules by resolver field, sorted by object and field
+// name. Arguments keep their schema order.
+func groupArguments(rules []argumentRule) []argumentField {
+	rules = slices.Clone(rules)
+	slices.SortStableFunc(rules, func(a, b argumentRule) int {
+		if c := strings.Compare(a.Object, b.Object); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	var out []argumentField
+	for _, rule := range rules {
+		if n := len(out); n > 0 && out[n-1].Object == rule.Object && out[n-1].Name == rule.Field {
+			out[n-1].Arguments = append(out[n-1].Arguments, rule)
+			continue
+		}
+		out = append(out, argumentField{Object: rule.Object, Name: rule.Field, Arguments: []argumentRule{rule}})
+	}
+	return out
+}
+
+// resultObject groups the validated fields of a single output object.
+type resultObject struct {
+	Object string
+	Fields []argumentRule
+}
+
+// groupResults groups the rules by object, sorted by name. Fields keep their
+// schema order.
+func groupResults(rules []argumentRule) []resultObject {
+	rules = slices.Clone(rules)
+	slices.SortStableFunc(rules, func(a, b argumentRule) int {
+		return strings.Compare(a.Object, b.Object)
+	})
+
+	var out []resultObject
+	for _, rule := range rules {
+		if n := len(out); n > 0 && out[n-1].Object == rule.Object {
+			out[n-1].Fields = append(out[n-1].Fields, rule)
+			continue
+		}
+		out = append(out, resultObject{Object: rule.Object, Fields: []argumentRule{rule}})
+	}
+	return out
+}
+
 func getArgumentValueAsString(arg *ast.Argument) (string, error) {
 	if arg == nil {
 		return "", errors.New("argument is nil")
@@ -210,6 +682,90 @@ func getArgumentValueAsString(arg *ast.Argument) (string, error) {
 	return s, nil
 }
 
+// getMessages reads the per-locale and per-rule messages of a @validate
+// directive. Locales are normalized to the underscore form used by the runtime
+// (pt-BR becomes pt_BR) and every rule/locale combination may appear only once.
+// Rules that are not a tag of rule and unknown placeholders in the texts are
+// returned as problems.
+func getMessages(owner, rule string, arg *ast.Argument) ([]customMessage, []ruleError, error) {
+	if arg == nil || arg.Value == nil {
+		return nil, nil, nil
+	}
+
+	var entries []*ast.Value
+	switch arg.Value.Kind {
+	case ast.NullValue:
+		return nil, nil, nil
+	case ast.ListValue:
+		for _, child := range arg.Value.Children {
+			entries = append(entries, child.Value)
+		}
+	default:
+		// A single object is coerced to a list of one.
+		entries = []*ast.Value{arg.Value}
+	}
+
+	tags := ruleTags(rule)
+
+	var problems []ruleError
+	seen := make(set, len(entries))
+	out := make([]customMessage, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Kind != ast.ObjectValue {
+			return nil, nil, fmt.Errorf("@%s on %s: messages must be objects with a text and a locale or rule (got %s)", directiveName, owner, entry.String())
+		}
+
+		var message customMessage
+		if v := entry.Children.ForName("locale"); v != nil && v.Kind == ast.StringValue {
+			message.Locale = strings.ReplaceAll(strings.TrimSpace(v.Raw), "-", "_")
+		}
+		ruleValue := entry.Children.ForName("rule")
+		if ruleValue != nil && ruleValue.Kind == ast.StringValue {
+			message.Rule = strings.TrimSpace(ruleValue.Raw)
+		}
+		textValue := entry.Children.ForName("text")
+		if textValue != nil && textValue.Kind == ast.StringValue {
+			message.Text = textValue.Raw
+		}
+
+		name := describeMessage(message)
+		switch {
+		case message.Locale == "" && message.Rule == "":
+			return nil, nil, fmt.Errorf("@%s on %s: every message requires a locale or a rule", directiveName, owner)
+		case strings.ContainsAny(message.Locale, " \t\":,"):
+			return nil, nil, fmt.Errorf("@%s on %s: invalid message locale %q", directiveName, owner, message.Locale)
+		case message.Text == "":
+			return nil, nil, fmt.Errorf("@%s on %s: %s requires a text", directiveName, owner, name)
+		case seen.contains(message.tagKey()):
+			return nil, nil, fmt.Errorf("@%s on %s: duplicate %s", directiveName, owner, name)
+		}
+
+		if message.Rule != "" && !tags.contains(message.Rule) {
+			problems = append(problems, ruleError{
+				pos:     ruleValue.Position,
+				message: fmt.Sprintf("@%s on %s: message for rule %q does not match any tag of the rule", directiveName, owner, message.Rule),
+			})
+		}
+
+		seen.add(message.tagKey())
+		problems = append(problems, messageProblems(owner, textValue, message.Text)...)
+		out = append(out, message)
+	}
+	return out, problems, nil
+}
+
+// describeMessage names a message entry in error messages.
+func describeMessage(m customMessage) string {
+	switch {
+	case m.Rule == "":
+		return fmt.Sprintf("message for locale %q", m.Locale)
+	case m.Locale == "":
+		return fmt.Sprintf("message for rule %q", m.Rule)
+	default:
+		return fmt.Sprintf("message for rule %q and locale %q", m.Rule, m.Locale)
+	}
+}
+
 func newGoTagDirective(key, value string) *ast.Directive {
 	return &ast.Directive{
 		Name: goTagDirectiveName,
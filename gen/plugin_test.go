@@ -40,6 +40,96 @@ const (
     }
 `
 
+	schemaWithArguments = `
+    directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+    type User {
+        id: ID!
+        friends(first: Int @validate(rule: "omitempty,gte=1")): [User!]!
+    }
+
+    type Query {
+        users(limit: Int @validate(rule: "gte=1,lte=100"), offset: Int): [User!]!
+        user(id: ID! @validate(rule: "uuid4", message: "id must be a UUID")): User
+    }
+`
+
+	schemaWithLocalizedMessages = `
+    directive @validate(rule: String!, message: String, messageKey: String, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+    input ValidateMessage {
+        locale: String
+        rule: String
+        text: String!
+    }
+
+    input PasswordInput {
+        password: String @validate(rule: "required,min=8", messageKey: "password_short", messages: [
+            {locale: "de", text: "Mindestens 8 Zeichen"},
+            {locale: "pt-BR", text: "Pelo menos 8 caracteres"},
+            {rule: "required", text: "Password is required"},
+            {rule: "min", locale: "de", text: "Mindestens {param} Zeichen"}
+        ])
+    }
+
+    type Query {
+        user(id: ID! @validate(rule: "uuid4", messages: {locale: "fr", text: "identifiant invalide"})): Boolean
+    }
+`
+
+	schemaWithResults = `
+    directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+
+    type User {
+        id: ID!
+        email: String! @validate(rule: "email", message: "{field} is not an email")
+        tags: [String!]! @validate(rule: "dive,min=2")
+    }
+
+    type Query {
+        version: String! @validate(rule: "semver")
+    }
+`
+
+	schemaWithStructRules = `
+    directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+    directive @validateStruct(rule: String!, message: String) repeatable on INPUT_OBJECT
+
+    input ContactInput @validateStruct(rule: "required_any=email phone", message: "provide an email or a phone") {
+        email: String
+        phone: String
+    }
+
+    input PeriodInput
+        @validateStruct(rule: "ordered=startDate endDate")
+        @validateStruct(rule: "sum=100 shares") {
+        startDate: String
+        endDate: String
+        shares: [Int!] @validate(rule: "dive,gte=0")
+    }
+`
+
+	schemaWithEach = `
+    directive @validate(rule: String, each: [String!], message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+
+    scalar Map
+
+    input TagsInput {
+        ids: [ID!]! @validate(rule: "min=1", each: "uuid4")
+        matrix: [[Int!]!] @validate(each: "gte=0")
+        shape: [[Int!]!] @validate(rule: "max=3", each: ["len=3", "ne=0"])
+        labels: Map @validate(each: "max=32")
+        names: [String] @validate(each: "min=2")
+        grid: [[Int]] @validate(each: ["max=3", "gte=0"])
+        owners: [ID] @validate(each: "required,uuid4")
+    }
+
+    type Query {
+        users(ids: [ID!] @validate(rule: "omitempty,max=10", each: ["uuid4"])): Int
+        tags: [String!]! @validate(each: "min=2")
+    }
+`
+
 	schemaWithoutMessage = `
     directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION
 
@@ -140,6 +230,118 @@ func TestPluginMutateSchema(t *testing.T) {
 
 		assert.ElementsMatch(t, []string{"MinimalInput"}, plugin.markerTypes.values())
 	})
+
+	t.Run("expands element rules", func(t *testing.T) {
+		schema := mustLoadSchema(t, schemaWithEach)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		fields := schema.Types["TagsInput"].Fields
+		assert.Equal(t, "min=1,dive,uuid4", goTagValue(t, fields.ForName("ids"), "validate"))
+		assert.Equal(t, "dive,dive,gte=0", goTagValue(t, fields.ForName("matrix"), "validate"))
+		assert.Equal(t, "max=3,dive,len=3,dive,ne=0", goTagValue(t, fields.ForName("shape"), "validate"))
+		assert.Equal(t, "dive,max=32", goTagValue(t, fields.ForName("labels"), "validate"))
+		assert.Equal(t, "dive,omitnil,min=2", goTagValue(t, fields.ForName("names"), "validate"))
+		assert.Equal(t, "dive,omitnil,max=3,dive,omitnil,gte=0", goTagValue(t, fields.ForName("grid"), "validate"))
+		assert.Equal(t, "dive,required,uuid4", goTagValue(t, fields.ForName("owners"), "validate"))
+
+		assert.Equal(t, []argumentRule{
+			{Object: "Query", Field: "users", Argument: "ids", Rule: "omitempty,max=10,dive,uuid4"},
+		}, plugin.arguments)
+		assert.Equal(t, []argumentRule{{Object: "Query", Field: "tags", Rule: "dive,min=2"}}, plugin.results)
+	})
+
+	t.Run("collects argument rules", func(t *testing.T) {
+		schema := mustLoadSchema(t, schemaWithArguments)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		assert.ElementsMatch(t, []argumentRule{
+			{Object: "User", Field: "friends", Argument: "first", Rule: "omitempty,gte=1"},
+			{Object: "Query", Field: "users", Argument: "limit", Rule: "gte=1,lte=100"},
+			{Object: "Query", Field: "user", Argument: "id", Rule: "uuid4", Message: "id must be a UUID"},
+		}, plugin.arguments)
+		assert.Empty(t, plugin.markerTypes.values())
+	})
+
+	t.Run("collects output field rules", func(t *testing.T) {
+		schema := mustLoadSchema(t, schemaWithResults)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		field := schema.Types["User"].Fields.ForName("email")
+		require.NotNil(t, field)
+		assert.Equal(t, "email", goTagValue(t, field, "validate"))
+		assert.Equal(t, "{field} is not an email", goTagValue(t, field, "message"))
+
+		assert.ElementsMatch(t, []argumentRule{
+			{Object: "User", Field: "email", Rule: "email", Message: "{field} is not an email"},
+			{Object: "User", Field: "tags", Rule: "dive,min=2"},
+			{Object: "Query", Field: "version", Rule: "semver"},
+		}, plugin.results)
+		assert.Empty(t, plugin.arguments)
+		assert.Empty(t, plugin.markerTypes.values())
+	})
+
+	t.Run("collects struct rules", func(t *testing.T) {
+		schema := mustLoadSchema(t, schemaWithStructRules)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		assert.Equal(t, map[string][]structRule{
+			"ContactInput": {{Name: "required_any", Param: "email phone", Message: "provide an email or a phone"}},
+			"PeriodInput":  {{Name: "ordered", Param: "startDate endDate"}, {Name: "sum", Param: "100 shares"}},
+		}, plugin.structRules)
+		assert.ElementsMatch(t, []string{"ContactInput", "PeriodInput"}, plugin.markerTypes.values())
+	})
+
+	t.Run("enforces oneOf input objects", func(t *testing.T) {
+		schema := mustLoadSchema(t, `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION
+
+    input AnswerInput @oneOf {
+        id: ID
+        text: String @validate(rule: "max=500")
+    }
+`)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		assert.Equal(t, map[string][]structRule{
+			"AnswerInput": {{Name: "oneOf", Param: "id text"}},
+		}, plugin.structRules)
+		assert.ElementsMatch(t, []string{"AnswerInput"}, plugin.markerTypes.values())
+	})
+
+	t.Run("injects localized messages", func(t *testing.T) {
+		schema := mustLoadSchema(t, schemaWithLocalizedMessages)
+		plugin := New().(*Plugin)
+
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		field := schema.Types["PasswordInput"].Fields.ForName("password")
+		require.NotNil(t, field)
+
+		assert.Equal(t, "password_short", goTagValue(t, field, "messageKey"))
+		assert.Equal(t, "Mindestens 8 Zeichen", goTagValue(t, field, "message-de"))
+		assert.Equal(t, "Pelo menos 8 caracteres", goTagValue(t, field, "message-pt_BR"))
+		assert.Equal(t, "Password is required", goTagValue(t, field, "message_required"))
+		assert.Equal(t, "Mindestens {param} Zeichen", goTagValue(t, field, "message_min-de"))
+		assert.False(t, hasGoTag(field, "message"))
+
+		assert.Equal(t, []argumentRule{{
+			Object:   "Query",
+			Field:    "user",
+			Argument: "id",
+			Rule:     "uuid4",
+			Messages: []customMessage{{Locale: "fr", Text: "identifiant invalide"}},
+		}}, plugin.arguments)
+	})
 }
 
 func TestPluginMutateSchemaErrors(t *testing.T) {
@@ -183,6 +385,139 @@ func TestPluginMutateSchemaErrors(t *testing.T) {
             `,
 			err: "@validate may only be applied once per field (BadInput.name)",
 		},
+		{
+			name: "duplicate directive on output field",
+			schema: `
+                directive @validate(rule: String!) repeatable on FIELD_DEFINITION
+
+                type User {
+                    email: String @validate(rule: "email") @validate(rule: "max=64")
+                }
+            `,
+			err: "@validate may only be applied once per field (User.email)",
+		},
+		{
+			name: "empty argument rule",
+			schema: `
+                directive @validate(rule: String!) on ARGUMENT_DEFINITION
+
+                type Query {
+                    users(limit: Int @validate(rule: "")): Int
+                }
+            `,
+			err: "@validate on Query.users.limit requires a rule",
+		},
+		{
+			name: "missing rule",
+			schema: `
+                directive @validate(rule: String, each: [String!]) on INPUT_FIELD_DEFINITION
+
+                input BadInput {
+                    tags: [String!] @validate(each: null)
+                }
+            `,
+			err: "@validate on BadInput.tags requires a rule",
+		},
+		{
+			name: "each of objects",
+			schema: `
+                directive @validate(rule: String, each: [String!]) on INPUT_FIELD_DEFINITION
+
+                input BadInput {
+                    tags: [String!] @validate(each: [3])
+                }
+            `,
+			err: "@validate on BadInput.tags: each must be a string or a list of strings (got 3)",
+		},
+		{
+			name: "duplicate argument directive",
+			schema: `
+                directive @validate(rule: String!) on ARGUMENT_DEFINITION
+
+                type Query {
+                    users(limit: Int @validate(rule: "gte=1") @validate(rule: "lte=5")): Int
+                }
+            `,
+			err: "@validate may only be applied once per argument (Query.users.limit)",
+		},
+		{
+			name: "message without locale",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION
+                input ValidateMessage { locale: String, text: String! }
+
+                input BadInput {
+                    name: String @validate(rule: "required", messages: [{text: "Pflichtfeld"}])
+                }
+            `,
+			err: "@validate on BadInput.name: every message requires a locale or a rule",
+		},
+		{
+			name: "message without text",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on ARGUMENT_DEFINITION
+                input ValidateMessage { locale: String!, text: String }
+
+                type Query {
+                    users(limit: Int @validate(rule: "gte=1", messages: [{locale: "de"}])): Int
+                }
+            `,
+			err: `@validate on Query.users.limit: message for locale "de" requires a text`,
+		},
+		{
+			name: "duplicate message locale",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION
+                input ValidateMessage { locale: String!, text: String! }
+
+                input BadInput {
+                    name: String @validate(rule: "required", messages: [
+                        {locale: "pt_BR", text: "a"},
+                        {locale: "pt-BR", text: "b"}
+                    ])
+                }
+            `,
+			err: `@validate on BadInput.name: duplicate message for locale "pt_BR"`,
+		},
+		{
+			name: "invalid message locale",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION
+                input ValidateMessage { locale: String!, text: String! }
+
+                input BadInput {
+                    name: String @validate(rule: "required", messages: [{locale: "de:CH", text: "a"}])
+                }
+            `,
+			err: `@validate on BadInput.name: invalid message locale "de:CH"`,
+		},
+		{
+			name: "duplicate rule message",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION
+                input ValidateMessage { locale: String, rule: String, text: String! }
+
+                input BadInput {
+                    name: String @validate(rule: "required", messages: [
+                        {rule: "required", locale: "de", text: "a"},
+                        {rule: "required", locale: "de", text: "b"}
+                    ])
+                }
+            `,
+			err: `@validate on BadInput.name: duplicate message for rule "required" and locale "de"`,
+		},
+		{
+			name: "message for unused rule",
+			schema: `
+                directive @validate(rule: String!, messages: [ValidateMessage!]) on ARGUMENT_DEFINITION
+                input ValidateMessage { locale: String, rule: String, text: String! }
+
+                type Query {
+                    users(limit: Int @validate(rule: "gte=1|eq=0", messages: [{rule: "lte", text: "too big"}])): Int
+                }
+            `,
+			err: `schema.graphql:6:87: @validate on Query.users.limit: message for rule "lte" does not match any tag of the rule`,
+		},
 	}
 
 	for _, tc := range cases {
@@ -198,6 +533,398 @@ func TestPluginMutateSchemaErrors(t *testing.T) {
 	}
 }
 
+func TestPluginMutateSchemaRuleErrors(t *testing.T) {
+	schema := mustLoadSchema(t, `
+    directive @validate(rule: String!, message: String, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+    input ValidateMessage { locale: String!, text: String! }
+
+    input RegisterInput {
+        password: String @validate(rule: "requird,min=8")
+        confirmPassword: String @validate(rule: "eqfield=pasword")
+        name: String @validate(rule: "max=64", message: "{field} is longer than {max}")
+    }
+
+    type Query {
+        users(limit: Int @validate(rule: "gte=1,lte=100,eqfield=offset"), offset: Int): Int
+        user(id: ID @validate(rule: "uuid4", messages: [{locale: "de", text: "{value} ist keine {typ}"}])): Int
+    }
+`)
+
+	err := New().(*Plugin).MutateSchema(schema)
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`schema.graphql:6:43: @validate on RegisterInput.password: unknown tag "requird"`,
+		`schema.graphql:7:50: @validate on RegisterInput.confirmPassword: eqfield references unknown field "pasword"`,
+		`schema.graphql:8:58: @validate on RegisterInput.name: unknown placeholder "{max}" in message`,
+		`schema.graphql:12:43: @validate on Query.users.limit: eqfield is not supported on arguments`,
+		`schema.graphql:13:79: @validate on Query.user.id: unknown placeholder "{typ}" in message`,
+	}, "\n"), err.Error())
+}
+
+func TestPluginMutateSchemaEachErrors(t *testing.T) {
+	schema := mustLoadSchema(t, `
+    directive @validate(rule: String, each: [String!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+    input TagsInput {
+        name: String @validate(each: "min=2")
+        tags: [String!] @validate(each: ["min=1", "max=8"])
+        ids: [ID!] @validate(rule: "dive,required", each: "uuid4")
+        matrix: [[Int!]] @validate(each: ["dive", "gt3"])
+    }
+
+    type Query {
+        users(ids: [ID!] @validate(each: "")): Int
+    }
+`)
+
+	err := New().(*Plugin).MutateSchema(schema)
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`schema.graphql:5:39: @validate on TagsInput.name: each requires a list or a custom scalar (got String)`,
+		`schema.graphql:6:41: @validate on TagsInput.tags: each lists 2 rules but [String!] has 1 level(s) of elements`,
+		`schema.graphql:7:60: @validate on TagsInput.ids: each cannot be combined with "dive" in rule`,
+		`schema.graphql:8:44: @validate on TagsInput.matrix: each rules cannot use "dive"`,
+		`schema.graphql:8:52: @validate on TagsInput.matrix: unknown tag "gt3"`,
+		`schema.graphql:12:43: @validate on Query.users.ids: empty tag`,
+	}, "\n"), err.Error())
+}
+
+func TestPluginMutateSchemaStructRuleErrors(t *testing.T) {
+	input := `
+    directive @validateStruct(rule: String!, message: String) repeatable on INPUT_OBJECT
+
+    input RangeInput
+        @validateStruct(rule: "ordered=from")
+        @validateStruct(rule: "required_any=from until")
+        @validateStruct(rule: "sum=all from to")
+        @validateStruct(rule: "distinct=from to", message: "{fields} must differ") {
+        from: Int
+        to: Int
+    }
+`
+
+	err := New().(*Plugin).MutateSchema(mustLoadSchema(t, input))
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`schema.graphql:5:32: @validateStruct on RangeInput: ordered expects at least two fields (got "from")`,
+		`schema.graphql:6:32: @validateStruct on RangeInput: required_any references unknown field "until"`,
+		`schema.graphql:7:32: @validateStruct on RangeInput: sum total "all" is not a number`,
+		`schema.graphql:8:32: @validateStruct on RangeInput: unknown struct rule "distinct"`,
+		`schema.graphql:8:61: @validateStruct on RangeInput: unknown placeholder "{fields}" in message`,
+	}, "\n"), err.Error())
+
+	err = New(WithStructRules("distinct")).(*Plugin).MutateSchema(mustLoadSchema(t, input))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "distinct")
+
+	err = New().(*Plugin).MutateSchema(mustLoadSchema(t, `
+    directive @validateStruct(rule: String!) repeatable on INPUT_OBJECT
+
+    input RangeInput @validateStruct(rule: "") {
+        from: Int
+    }
+`))
+	assert.EqualError(t, err, "@validateStruct on RangeInput requires a rule")
+}
+
+func TestPluginWithCustomTags(t *testing.T) {
+	input := `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION
+
+    input AccountInput {
+        iban: String @validate(rule: "required,iban")
+        bic: String @validate(rule: "omitempty,bic|swift")
+    }
+`
+
+	err := New().(*Plugin).MutateSchema(mustLoadSchema(t, input))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown tag "iban"`)
+	assert.Contains(t, err.Error(), `unknown tag "swift"`)
+
+	plugin := New(WithCustomTags("iban", "swift")).(*Plugin)
+	require.NoError(t, plugin.MutateSchema(mustLoadSchema(t, input)))
+	assert.ElementsMatch(t, []string{"AccountInput"}, plugin.markerTypes.values())
+}
+
+func TestPluginAliases(t *testing.T) {
+	input := `
+    directive @validate(rule: String, each: [String!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+    directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+
+    extend schema @validateAlias(name: "emailAddress", rule: "required,email,max=254")
+
+    input InviteInput {
+        email: String @validate(rule: "emailAddress")
+        cc: [String!] @validate(rule: "max=5", each: "emailAddress")
+        code: String @validate(rule: "omitempty,inviteCode")
+    }
+
+    type Query {
+        user(email: String! @validate(rule: "emailAddress")): Int
+    }
+`
+
+	plugin := New(WithAliases(map[string]string{"inviteCode": "len=8,alphanum"})).(*Plugin)
+	require.NoError(t, plugin.MutateSchema(mustLoadSchema(t, input)))
+
+	fields := plugin.fieldRules["InviteInput"]
+	assert.Equal(t, "required,email,max=254", fields["email"])
+	assert.Equal(t, "max=5,dive,required,email,max=254", fields["cc"])
+	assert.Equal(t, "omitempty,len=8,alphanum", fields["code"])
+	assert.Equal(t, "required,email,max=254", plugin.arguments[0].Rule)
+}
+
+func TestPluginAliasErrors(t *testing.T) {
+	err := New(WithAliases(map[string]string{"email": "max=254"})).(*Plugin).MutateSchema(mustLoadSchema(t, `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION
+    directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+
+    extend schema
+        @validateAlias(name: "handle", rule: "required,slug")
+        @validateAlias(name: "short", rule: "max=8")
+
+    input InviteInput {
+        name: String @validate(rule: "short|alpha")
+        code: String @validate(rule: "omitempty,shrt")
+    }
+`))
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`alias "email": shadows the tag of the same name`,
+		`schema.graphql:6:47: alias "handle": unknown tag "slug"`,
+		`schema.graphql:10:39: @validate on InviteInput.name: alias "short" cannot be combined with other tags using '|'`,
+		`schema.graphql:11:39: @validate on InviteInput.code: unknown tag "shrt"`,
+	}, "\n"), err.Error())
+
+	for _, tc := range []struct{ directives, err string }{
+		{`@validateAlias(name: "a", rule: "min=1") @validateAlias(name: "a", rule: "min=2")`, `duplicate @validateAlias "a"`},
+		{`@validateAlias(name: "a b", rule: "min=1")`, `invalid alias name "a b"`},
+		{`@validateAlias(name: "a", rule: "")`, `@validateAlias "a" requires a rule`},
+	} {
+		err := New().(*Plugin).MutateSchema(mustLoadSchema(t, `
+    directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+    extend schema `+tc.directives))
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestPluginScalarRules(t *testing.T) {
+	input := `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION
+    directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+
+    extend schema @validateAlias(name: "emailAddress", rule: "email,max=254")
+
+    scalar Email
+
+    input InviteInput {
+        id: ID!
+        email: Email
+        cc: [Email]
+        groups: [[ID!]!]
+        note: String
+    }
+
+    input ContactInput {
+        email: Email! @validate(rule: "required")
+    }
+
+    input PlainInput {
+        note: String
+    }
+
+    type Query {
+        user(id: ID!): Int
+    }
+`
+
+	plugin := New(WithScalarRules(map[string]string{"Email": "emailAddress", "ID": "uuid4"})).(*Plugin)
+	schema := mustLoadSchema(t, input)
+	require.NoError(t, plugin.MutateSchema(schema))
+
+	fields := schema.Types["InviteInput"].Fields
+	assert.Equal(t, "uuid4", goTagValue(t, fields.ForName("id"), "validate"))
+	assert.Equal(t, "omitnil,email,max=254", goTagValue(t, fields.ForName("email"), "validate"))
+	assert.Equal(t, "dive,omitnil,email,max=254", goTagValue(t, fields.ForName("cc"), "validate"))
+	assert.Equal(t, "dive,dive,uuid4", goTagValue(t, fields.ForName("groups"), "validate"))
+	assert.False(t, hasGoTag(fields.ForName("note"), "validate"))
+	assert.Equal(t, "required", goTagValue(t, schema.Types["ContactInput"].Fields.ForName("email"), "validate"))
+
+	assert.Equal(t, "uuid4", plugin.fieldRules["InviteInput"]["id"])
+	assert.ElementsMatch(t, []string{"ContactInput", "InviteInput"}, plugin.markerTypes.values())
+	assert.Empty(t, plugin.arguments)
+
+	err := New(WithScalarRules(map[string]string{
+		"Email":  "dive,emial",
+		"Input":  "required",
+		"Phone":  "e164",
+		"String": "eqfield=other",
+	})).(*Plugin).MutateSchema(mustLoadSchema(t, input+"input Input { id: ID }"))
+	require.Error(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`default rule for scalar "Email": default rules cannot use "dive"`,
+		`default rule for scalar "Email": unknown tag "emial"`,
+		`default rule for scalar "Input": Input is not a scalar`,
+		`default rule for scalar "Phone": scalar is not defined`,
+		`default rule for scalar "String": eqfield references unknown field "other"`,
+	}, "\n"), err.Error())
+}
+
+func TestPluginSkip(t *testing.T) {
+	input := `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @skipValidate on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+
+    input AddressInput {
+        city: String @validate(rule: "required")
+    }
+
+    input ImportInput {
+        id: ID! @skipValidate
+        address: AddressInput @skipValidate
+        billing: AddressInput
+    }
+
+    type Mutation {
+        importUsers(input: ImportInput!, limit: Int @validate(rule: "lte=100")): Int @skipValidate
+        register(input: ImportInput! @skipValidate, limit: Int @validate(rule: "lte=100")): Int
+    }
+`
+
+	plugin := New(WithScalarRules(map[string]string{"ID": "uuid4"})).(*Plugin)
+	schema := mustLoadSchema(t, input)
+	require.NoError(t, plugin.MutateSchema(schema))
+
+	fields := schema.Types["ImportInput"].Fields
+	assert.False(t, hasGoTag(fields.ForName("id"), "validate"))
+	assert.Equal(t, "-", goTagValue(t, fields.ForName("address"), "validate"))
+	assert.False(t, hasGoTag(fields.ForName("billing"), "validate"))
+	assert.Equal(t, "-", plugin.fieldRules["ImportInput"]["address"])
+	assert.ElementsMatch(t, []string{"AddressInput"}, plugin.markerTypes.values())
+
+	assert.ElementsMatch(t, []skipRule{
+		{Object: "Mutation", Field: "importUsers"},
+		{Object: "Mutation", Field: "register", Arguments: []string{"input"}},
+	}, plugin.skips)
+
+	for owner, schema := range map[string]string{
+		"ImportInput.id": `input ImportInput { id: ID @skipValidate @validate(rule: "required") }`,
+		"Query.user.id":  `type Query { user(id: ID @validate(rule: "uuid4") @skipValidate): Int }`,
+		"Query.version":  `type Query { version: String @skipValidate @validate(rule: "semver") }`,
+	} {
+		err := New().(*Plugin).MutateSchema(mustLoadSchema(t, `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @skipValidate on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+`+schema))
+		assert.EqualError(t, err, "@skipValidate and @validate cannot be combined ("+owner+")")
+	}
+}
+
+func TestPluginTransform(t *testing.T) {
+	directives := `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @skipValidate on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @validateTransform(ops: [String!]!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+`
+
+	plugin := New(WithCustomTransforms("slug")).(*Plugin)
+	schema := mustLoadSchema(t, directives+`
+    input SignupInput {
+        email: String! @validateTransform(ops: ["trim", "lower"]) @validate(rule: "email")
+        name: String @validateTransform(ops: "collapse")
+        tags: [String!] @validateTransform(ops: ["slug"]) @skipValidate
+    }
+
+    type Query {
+        users(name: String @validateTransform(ops: ["trim", "nfc"]), first: Int @validate(rule: "lte=50")): Int
+        signup(input: SignupInput!, code: ID! @validateTransform(ops: "upper") @validate(rule: "len=6")): Int
+    }
+`)
+	require.NoError(t, plugin.MutateSchema(schema))
+
+	fields := schema.Types["SignupInput"].Fields
+	assert.Equal(t, "trim,lower", goTagValue(t, fields.ForName("email"), "transform"))
+	assert.Equal(t, "email", goTagValue(t, fields.ForName("email"), "validate"))
+	assert.Equal(t, "collapse", goTagValue(t, fields.ForName("name"), "transform"))
+	assert.False(t, hasGoTag(fields.ForName("name"), "validate"))
+	assert.Equal(t, "slug", goTagValue(t, fields.ForName("tags"), "transform"))
+
+	assert.ElementsMatch(t, []argumentRule{
+		{Object: "Query", Field: "users", Argument: "name", Transforms: []string{"trim", "nfc"}},
+		{Object: "Query", Field: "users", Argument: "first", Rule: "lte=50"},
+		{Object: "Query", Field: "signup", Argument: "code", Rule: "len=6", Transforms: []string{"upper"}},
+	}, plugin.arguments)
+
+	t.Run("errors", func(t *testing.T) {
+		err := New().(*Plugin).MutateSchema(mustLoadSchema(t, directives+`
+    input SignupInput {
+        age: Int @validateTransform(ops: ["trim"])
+        email: String @validateTransform(ops: ["trim", "slug"])
+    }
+
+    type Query {
+        signup(input: SignupInput!, active: [Boolean!] @validateTransform(ops: "lower")): Int
+    }
+`))
+		require.Error(t, err)
+		assert.Equal(t, strings.Join([]string{
+			`schema.graphql:7:19: @validateTransform on SignupInput.age: only strings can be transformed (got Int)`,
+			`schema.graphql:8:57: @validateTransform on SignupInput.email: unknown transform "slug"`,
+			`schema.graphql:12:57: @validateTransform on Query.signup.active: only strings can be transformed (got [Boolean!])`,
+		}, "\n"), err.Error())
+
+		err = New().(*Plugin).MutateSchema(mustLoadSchema(t, directives+`
+    type Query { users(name: String @validateTransform(ops: [])): Int }
+`))
+		assert.EqualError(t, err, "@validateTransform on Query.users.name requires ops")
+	})
+}
+
+func TestPluginDefault(t *testing.T) {
+	directives := `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @validateDefault(name: String!) on INPUT_FIELD_DEFINITION
+    scalar Time
+`
+
+	plugin := New(WithCustomDefaults("locale")).(*Plugin)
+	schema := mustLoadSchema(t, directives+`
+    input ProfileInput {
+        createdAt: Time @validateDefault(name: "now") @validate(rule: "required")
+        tags: [String!] @validateDefault(name: "empty")
+        locale: String @validateDefault(name: "locale") @validate(rule: "required,bcp47_language_tag")
+        bio: String
+    }
+`)
+	require.NoError(t, plugin.MutateSchema(schema))
+
+	fields := schema.Types["ProfileInput"].Fields
+	assert.Equal(t, "now", goTagValue(t, fields.ForName("createdAt"), "default"))
+	assert.Equal(t, "empty", goTagValue(t, fields.ForName("tags"), "default"))
+	assert.Equal(t, "locale", goTagValue(t, fields.ForName("locale"), "default"))
+	assert.False(t, hasGoTag(fields.ForName("bio"), "default"))
+
+	t.Run("errors", func(t *testing.T) {
+		err := New().(*Plugin).MutateSchema(mustLoadSchema(t, directives+`
+    input ProfileInput {
+        createdAt: Time! @validateDefault(name: "now")
+        locale: String @validateDefault(name: "locale")
+        limit: Int = 10 @validateDefault(name: "empty")
+        startsAt: String @validateDefault(name: "now")
+    }
+`))
+		require.Error(t, err)
+		assert.Equal(t, strings.Join([]string{
+			`schema.graphql:7:27: @validateDefault on ProfileInput.createdAt: non-null fields are always set (got Time!)`,
+			`schema.graphql:8:48: @validateDefault on ProfileInput.locale: unknown default "locale"`,
+			`schema.graphql:9:26: @validateDefault on ProfileInput.limit cannot be combined with the default value 10`,
+			`schema.graphql:9:49: @validateDefault on ProfileInput.limit: empty requires a list, an input object or a scalar bound to a Go slice or map (got Int)`,
+			`schema.graphql:10:50: @validateDefault on ProfileInput.startsAt: now requires a scalar bound to time.Time (got String)`,
+		}, "\n"), err.Error())
+	})
+}
+
 func TestPluginMutateConfig(t *testing.T) {
 	t.Run("adds directive definitions", func(t *testing.T) {
 		cfg := &config.Config{Directives: map[string]config.DirectiveConfig{}}
@@ -207,6 +934,11 @@ func TestPluginMutateConfig(t *testing.T) {
 
 		assert.True(t, cfg.Directives[goTagDirectiveName].SkipRuntime)
 		assert.True(t, cfg.Directives[directiveName].SkipRuntime)
+		assert.True(t, cfg.Directives[structDirectiveName].SkipRuntime)
+		assert.True(t, cfg.Directives[aliasDirectiveName].SkipRuntime)
+		assert.True(t, cfg.Directives[skipDirectiveName].SkipRuntime)
+		assert.True(t, cfg.Directives[transformDirectiveName].SkipRuntime)
+		assert.True(t, cfg.Directives[defaultDirectiveName].SkipRuntime)
 	})
 
 	t.Run("respects existing definitions", func(t *testing.T) {
@@ -221,6 +953,66 @@ func TestPluginMutateConfig(t *testing.T) {
 		assert.False(t, cfg.Directives[goTagDirectiveName].SkipRuntime)
 		assert.False(t, cfg.Directives[directiveName].SkipRuntime)
 	})
+
+	t.Run("checks each on custom scalars", func(t *testing.T) {
+		schema := mustLoadSchema(t, `
+    directive @validate(rule: String, each: [String!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+    scalar Labels
+    scalar Any
+
+    input EventInput {
+        labels: Labels @validate(each: "max=32")
+        payload: Any @validate(each: "required")
+    }
+`)
+		plugin := New().(*Plugin)
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		cfg := &config.Config{
+			Directives: map[string]config.DirectiveConfig{},
+			Models: config.TypeMap{
+				"Labels": {Model: config.StringList{"map[string]any"}},
+				"Any":    {Model: config.StringList{"any"}},
+			},
+			Schema: schema,
+		}
+
+		err := plugin.MutateConfig(cfg)
+		assert.EqualError(t, err, `schema.graphql:9:39: @validate on EventInput.payload: each requires a list or a scalar bound to a Go slice or map (Any is bound to any)`)
+	})
+
+	t.Run("checks built-in defaults on custom scalars", func(t *testing.T) {
+		schema := mustLoadSchema(t, `
+    directive @validateDefault(name: String!) on INPUT_FIELD_DEFINITION
+
+    scalar Labels
+    scalar Any
+
+    input EventInput {
+        labels: Labels @validateDefault(name: "empty")
+        payload: Any @validateDefault(name: "empty")
+        createdAt: Labels @validateDefault(name: "now")
+    }
+`)
+		plugin := New().(*Plugin)
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		cfg := &config.Config{
+			Directives: map[string]config.DirectiveConfig{},
+			Models: config.TypeMap{
+				"Labels": {Model: config.StringList{"map[string]any"}},
+				"Any":    {Model: config.StringList{"any"}},
+			},
+			Schema: schema,
+		}
+
+		err := plugin.MutateConfig(cfg)
+		assert.EqualError(t, err, strings.Join([]string{
+			`schema.graphql:9:46: @validateDefault on EventInput.payload: empty requires a list, an input object or a scalar bound to a Go slice or map (Any is bound to any)`,
+			`schema.graphql:10:51: @validateDefault on EventInput.createdAt: now requires a scalar bound to time.Time (Labels is bound to map[string]any)`,
+		}, "\n"))
+	})
 }
 
 func TestPluginGenerateCode(t *testing.T) {
@@ -268,6 +1060,163 @@ func TestPluginGenerateCode(t *testing.T) {
 		require.NotEqual(t, -1, proxyIdx)
 		assert.Less(t, alphaIdx, monitorIdx)
 		assert.Less(t, monitorIdx, proxyIdx)
+		assert.NotContains(t, output, "RegisterArguments")
+	})
+
+	t.Run("registers argument rules", func(t *testing.T) {
+		plugin := &Plugin{markerTypes: make(set), arguments: []argumentRule{
+			{Object: "Query", Field: "users", Argument: "limit", Rule: "gte=1"},
+			{Object: "Mutation", Field: "deleteUser", Argument: "id", Rule: "uuid4", Message: "bad id"},
+			{Object: "Query", Field: "users", Argument: "offset", Rule: "gte=0"},
+			{Object: "Query", Field: "user", Argument: "id", Rule: "uuid4", MessageKey: "bad_id", Messages: []customMessage{
+				{Locale: "de", Text: "ungültige ID"},
+				{Locale: "fr", Text: "identifiant invalide"},
+			}},
+			{Object: "Query", Field: "users", Argument: "first", Rule: "lte=50", Messages: []customMessage{
+				{Rule: "lte", Text: "at most {param}"},
+				{Rule: "lte", Locale: "de", Text: "höchstens {param}"},
+			}},
+		}}
+
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		data := &codegen.Data{Config: newCodegenConfig(t, modelPath)}
+
+		require.NoError(t, plugin.GenerateCode(data))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "validatable_gen.go"))
+		require.NoError(t, err)
+
+		output := string(content)
+		assert.Contains(t, output, `"github.com/danutavadanei/gqlgen-validate/runtime"`)
+		assert.Contains(t, output, `runtime.Argument{Name: "id", Rule: "uuid4", Message: "bad id"}`)
+		assert.Contains(t, output, `runtime.Argument{Name: "id", Rule: "uuid4", MessageKey: "bad_id", Messages: []runtime.Message{{Locale: "de", Text: "ungültige ID"}, {Locale: "fr", Text: "identifiant invalide"}}}`)
+		assert.Contains(t, output, `runtime.Argument{Name: "first", Rule: "lte=50", Messages: []runtime.Message{{Rule: "lte", Text: "at most {param}"}, {Rule: "lte", Locale: "de", Text: "höchstens {param}"}}}`)
+
+		mutationIdx := strings.Index(output, `runtime.RegisterArguments("Mutation", "deleteUser",`)
+		limitIdx := strings.Index(output, `runtime.Argument{Name: "limit", Rule: "gte=1"}`)
+		offsetIdx := strings.Index(output, `runtime.Argument{Name: "offset", Rule: "gte=0"}`)
+
+		require.NotEqual(t, -1, mutationIdx)
+		require.NotEqual(t, -1, limitIdx)
+		require.NotEqual(t, -1, offsetIdx)
+		assert.Less(t, mutationIdx, limitIdx)
+		assert.Less(t, limitIdx, offsetIdx)
+	})
+
+	t.Run("registers struct rules", func(t *testing.T) {
+		plugin := &Plugin{
+			markerTypes: set{"PeriodInput": {}, "ContactInput": {}, "PlainInput": {}},
+			structRules: map[string][]structRule{
+				"PeriodInput":  {{Name: "ordered", Param: "startDate endDate"}, {Name: "sum", Param: "100 shares", Message: "shares must add up to {param}"}},
+				"ContactInput": {{Name: "required_any", Param: "email phone"}},
+			},
+		}
+
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		data := &codegen.Data{Config: newCodegenConfig(t, modelPath)}
+
+		require.NoError(t, plugin.GenerateCode(data))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "validatable_gen.go"))
+		require.NoError(t, err)
+
+		output := string(content)
+		contactIdx := strings.Index(output, `runtime.RegisterStructRules(ContactInput{},
+		runtime.StructRule{Name: "required_any", Param: "email phone"},
+	)`)
+		periodIdx := strings.Index(output, `runtime.RegisterStructRules(PeriodInput{},
+		runtime.StructRule{Name: "ordered", Param: "startDate endDate"},
+		runtime.StructRule{Name: "sum", Param: "100 shares", Message: "shares must add up to {param}"},
+	)`)
+
+		require.NotEqual(t, -1, contactIdx, output)
+		require.NotEqual(t, -1, periodIdx, output)
+		assert.Less(t, contactIdx, periodIdx)
+		assert.NotContains(t, output, "RegisterStructRules(PlainInput")
+	})
+
+	t.Run("registers output field rules", func(t *testing.T) {
+		plugin := &Plugin{markerTypes: make(set), results: []argumentRule{
+			{Object: "User", Field: "email", Rule: "email", Message: "bad email"},
+			{Object: "Query", Field: "version", Rule: "semver"},
+			{Object: "User", Field: "tags", Rule: "dive,min=2"},
+		}}
+
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		data := &codegen.Data{Config: newCodegenConfig(t, modelPath)}
+
+		require.NoError(t, plugin.GenerateCode(data))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "validatable_gen.go"))
+		require.NoError(t, err)
+
+		output := string(content)
+		assert.NotContains(t, output, "RegisterArguments")
+
+		queryIdx := strings.Index(output, `runtime.RegisterResults("Query",`)
+		userIdx := strings.Index(output, `runtime.RegisterResults("User",`)
+		emailIdx := strings.Index(output, `runtime.Argument{Name: "email", Rule: "email", Message: "bad email"},`)
+		tagsIdx := strings.Index(output, `runtime.Argument{Name: "tags", Rule: "dive,min=2"},`)
+
+		require.NotEqual(t, -1, queryIdx)
+		require.NotEqual(t, -1, userIdx)
+		require.NotEqual(t, -1, emailIdx)
+		require.NotEqual(t, -1, tagsIdx)
+		assert.Less(t, queryIdx, userIdx)
+		assert.Less(t, userIdx, emailIdx)
+		assert.Less(t, emailIdx, tagsIdx)
+	})
+
+	t.Run("registers transformed arguments", func(t *testing.T) {
+		plugin := &Plugin{markerTypes: make(set), arguments: []argumentRule{
+			{Object: "Query", Field: "users", Argument: "name", Transforms: []string{"trim", "nfc"}},
+			{Object: "Query", Field: "users", Argument: "code", Rule: "len=6", Transforms: []string{"upper"}},
+		}}
+
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		require.NoError(t, plugin.GenerateCode(&codegen.Data{Config: newCodegenConfig(t, modelPath)}))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "validatable_gen.go"))
+		require.NoError(t, err)
+
+		output := string(content)
+		assert.Contains(t, output, `runtime.Argument{Name: "name", Transforms: []string{"trim", "nfc"}},`)
+		assert.Contains(t, output, `runtime.Argument{Name: "code", Rule: "len=6", Transforms: []string{"upper"}},`)
+	})
+
+	t.Run("registers skipped fields", func(t *testing.T) {
+		plugin := &Plugin{markerTypes: make(set), skips: []skipRule{
+			{Object: "Query", Field: "user", Arguments: []string{"id", "filter"}},
+			{Object: "Mutation", Field: "importUsers"},
+		}}
+
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		require.NoError(t, plugin.GenerateCode(&codegen.Data{Config: newCodegenConfig(t, modelPath)}))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "validatable_gen.go"))
+		require.NoError(t, err)
+
+		output := string(content)
+		mutationIdx := strings.Index(output, `runtime.RegisterSkipped("Mutation", "importUsers")`)
+		queryIdx := strings.Index(output, `runtime.RegisterSkipped("Query", "user", "id", "filter")`)
+		require.NotEqual(t, -1, mutationIdx)
+		require.NotEqual(t, -1, queryIdx)
+		assert.Less(t, mutationIdx, queryIdx)
 	})
 }
 
@@ -0,0 +1,325 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	skipTag    = "-"
+	diveTag    = "dive"
+	keysTag    = "keys"
+	endKeysTag = "endkeys"
+)
+
+// builtinTags lists the tags understood by go-playground/validator v10 without
+// any registration, including its baked-in aliases and control tags.
+var builtinTags = set{
+	// Control tags.
+	"-": {}, "dive": {}, "keys": {}, "endkeys": {}, "omitempty": {}, "omitnil": {}, "omitzero": {},
+	"structonly": {}, "nostructlevel": {},
+
+	// Baked-in aliases.
+	"iscolor": {}, "country_code": {}, "eu_country_code": {},
+
+	// Baked-in validators.
+	"required": {}, "required_if": {}, "required_unless": {}, "skip_unless": {}, "required_with": {},
+	"required_with_all": {}, "required_without": {}, "required_without_all": {}, "excluded_if": {},
+	"excluded_unless": {}, "excluded_with": {}, "excluded_with_all": {}, "excluded_without": {},
+	"excluded_without_all": {}, "isdefault": {}, "len": {}, "min": {}, "max": {}, "eq": {},
+	"eq_ignore_case": {}, "ne": {}, "ne_ignore_case": {}, "lt": {}, "lte": {}, "gt": {}, "gte": {},
+	"eqfield": {}, "eqcsfield": {}, "necsfield": {}, "gtcsfield": {}, "gtecsfield": {}, "ltcsfield": {},
+	"ltecsfield": {}, "nefield": {}, "gtefield": {}, "gtfield": {}, "ltefield": {}, "ltfield": {},
+	"fieldcontains": {}, "fieldexcludes": {}, "alpha": {}, "alphanum": {}, "alphaunicode": {},
+	"alphanumunicode": {}, "boolean": {}, "numeric": {}, "number": {}, "hexadecimal": {}, "hexcolor": {},
+	"rgb": {}, "rgba": {}, "hsl": {}, "hsla": {}, "e164": {}, "email": {}, "url": {}, "http_url": {},
+	"uri": {}, "urn_rfc2141": {}, "file": {}, "filepath": {}, "base32": {}, "base64": {}, "base64url": {},
+	"base64rawurl": {}, "contains": {}, "containsany": {}, "containsrune": {}, "excludes": {},
+	"excludesall": {}, "excludesrune": {}, "startswith": {}, "endswith": {}, "startsnotwith": {},
+	"endsnotwith": {}, "image": {}, "isbn": {}, "isbn10": {}, "isbn13": {}, "issn": {}, "eth_addr": {},
+	"eth_addr_checksum": {}, "btc_addr": {}, "btc_addr_bech32": {}, "uuid": {}, "uuid3": {}, "uuid4": {},
+	"uuid5": {}, "uuid_rfc4122": {}, "uuid3_rfc4122": {}, "uuid4_rfc4122": {}, "uuid5_rfc4122": {},
+	"ulid": {}, "md4": {}, "md5": {}, "sha256": {}, "sha384": {}, "sha512": {}, "ripemd128": {},
+	"ripemd160": {}, "tiger128": {}, "tiger160": {}, "tiger192": {}, "ascii": {}, "printascii": {},
+	"multibyte": {}, "datauri": {}, "latitude": {}, "longitude": {}, "ssn": {}, "ipv4": {}, "ipv6": {},
+	"ip": {}, "cidrv4": {}, "cidrv6": {}, "cidr": {}, "tcp4_addr": {}, "tcp6_addr": {}, "tcp_addr": {},
+	"udp4_addr": {}, "udp6_addr": {}, "udp_addr": {}, "ip4_addr": {}, "ip6_addr": {}, "ip_addr": {},
+	"unix_addr": {}, "mac": {}, "hostname": {}, "hostname_rfc1123": {}, "fqdn": {}, "unique": {},
+	"oneof": {}, "oneofci": {}, "html": {}, "html_encoded": {}, "url_encoded": {}, "dir": {},
+	"dirpath": {}, "json": {}, "jwt": {}, "hostname_port": {}, "port": {}, "lowercase": {},
+	"uppercase": {}, "datetime": {}, "timezone": {}, "iso3166_1_alpha2": {}, "iso3166_1_alpha2_eu": {},
+	"iso3166_1_alpha3": {}, "iso3166_1_alpha3_eu": {}, "iso3166_1_alpha_numeric": {},
+	"iso3166_1_alpha_numeric_eu": {}, "iso3166_2": {}, "iso4217": {}, "iso4217_numeric": {},
+	"bcp47_language_tag": {}, "postcode_iso3166_alpha2": {}, "postcode_iso3166_alpha2_field": {},
+	"bic": {}, "semver": {}, "dns_rfc1035_label": {}, "credit_card": {}, "cve": {}, "luhn_checksum": {},
+	"mongodb": {}, "mongodb_connection_string": {}, "cron": {}, "spicedb": {}, "ein": {}, "validateFn": {},
+}
+
+// placeholders lists the names that custom messages may reference as {name}.
+var placeholders = set{"field": {}, "param": {}, "value": {}, "rule": {}, "path": {}}
+
+// placeholderPattern matches named placeholders. Positional translation
+// parameters such as {0} are not names and are left alone.
+var placeholderPattern = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// controlTags may not be combined with other tags through '|'.
+var controlTags = set{
+	"-": {}, "dive": {}, "keys": {}, "endkeys": {}, "omitempty": {}, "omitnil": {}, "omitzero": {},
+	"structonly": {}, "nostructlevel": {},
+}
+
+// paramTags are the built-in validators that cannot run without a parameter.
+var paramTags = set{
+	"len": {}, "min": {}, "max": {}, "eq": {}, "ne": {}, "lt": {}, "lte": {}, "gt": {}, "gte": {},
+	"eq_ignore_case": {}, "ne_ignore_case": {}, "oneof": {}, "oneofci": {}, "contains": {},
+	"containsany": {}, "containsrune": {}, "excludes": {}, "excludesall": {}, "excludesrune": {},
+	"startswith": {}, "endswith": {}, "startsnotwith": {}, "endsnotwith": {}, "datetime": {},
+	"postcode_iso3166_alpha2": {}, "postcode_iso3166_alpha2_field": {},
+}
+
+// numericTags are the paramTags whose parameter the validator parses as a number,
+// or as a duration on time.Duration values.
+var numericTags = set{"len": {}, "min": {}, "max": {}, "lt": {}, "lte": {}, "gt": {}, "gte": {}}
+
+// ruleError is a problem found in a rule string, reported at its schema position.
+type ruleError struct {
+	pos     *ast.Position
+	message string
+}
+
+func (e ruleError) Error() string {
+	if e.pos == nil {
+		return e.message
+	}
+
+	name := "schema"
+	if e.pos.Src != nil && e.pos.Src.Name != "" {
+		name = e.pos.Src.Name
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", name, e.pos.Line, e.pos.Column, e.message)
+}
+
+// checkRule verifies a rule string written in GraphQL casing against the validator
+// tag grammar. Known tags are looked up in tags; siblings holds the fields that
+// cross-field rules may reference and is nil when the rule belongs to an argument.
+func checkRule(rule string, tags, siblings set) []string {
+	var problems []string
+	inKeys := false
+	prev := ""
+
+	for _, segment := range strings.Split(rule, ",") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			problems = append(problems, "empty tag")
+			continue
+		}
+
+		alternatives := strings.Split(segment, "|")
+		for _, alternative := range alternatives {
+			alternative = strings.TrimSpace(alternative)
+			name, params, _ := strings.Cut(alternative, "=")
+
+			switch {
+			case name == "":
+				problems = append(problems, "empty tag")
+				continue
+			case !tags.contains(name):
+				problems = append(problems, fmt.Sprintf("unknown tag %q", name))
+				continue
+			case len(alternatives) > 1 && controlTags.contains(name):
+				problems = append(problems, fmt.Sprintf("%q cannot be combined with other tags using '|'", name))
+				continue
+			}
+
+			problems = append(problems, checkParam(name, params)...)
+			problems = append(problems, checkFieldReferences(name, params, siblings)...)
+		}
+
+		switch name, _, _ := strings.Cut(segment, "="); name {
+		case keysTag:
+			if prev != diveTag {
+				problems = append(problems, fmt.Sprintf("%q must immediately follow %q", keysTag, diveTag))
+			}
+			inKeys = true
+		case endKeysTag:
+			if !inKeys {
+				problems = append(problems, fmt.Sprintf("%q without a preceding %q", endKeysTag, keysTag))
+			}
+			inKeys = false
+		}
+		prev = segment
+	}
+
+	if inKeys {
+		problems = append(problems, fmt.Sprintf("%q without a matching %q", keysTag, endKeysTag))
+	}
+
+	return problems
+}
+
+// checkParam reports a missing parameter of a built-in validator, and one the
+// validator would fail to parse as a number.
+func checkParam(name, param string) []string {
+	if !paramTags.contains(name) {
+		return nil
+	}
+	if strings.TrimSpace(param) == "" {
+		return []string{fmt.Sprintf("%s requires a parameter", name)}
+	}
+	if numericTags.contains(name) && !isNumeric(param) {
+		return []string{fmt.Sprintf("%s requires a number (got %q)", name, param)}
+	}
+	return nil
+}
+
+// isNumeric reports whether param parses the way the validator reads numeric
+// parameters: as an integer, a float or a duration.
+func isNumeric(param string) bool {
+	if _, err := strconv.ParseInt(param, 0, 64); err == nil {
+		return true
+	}
+	if _, err := strconv.ParseFloat(param, 64); err == nil {
+		return true
+	}
+	_, err := time.ParseDuration(param)
+	return err == nil
+}
+
+// ruleTags returns the names of the tags used in rule.
+func ruleTags(rule string) set {
+	tags := make(set)
+	for _, segment := range strings.Split(rule, ",") {
+		for _, alternative := range strings.Split(segment, "|") {
+			if name, _, _ := strings.Cut(strings.TrimSpace(alternative), "="); name != "" {
+				tags.add(name)
+			}
+		}
+	}
+	return tags
+}
+
+// ruleProblems checks the rule of a @validate directive and reports every problem
+// at the position of its rule argument.
+func ruleProblems(owner string, arg *ast.Argument, rule string, tags, siblings set) []ruleError {
+	var pos *ast.Position
+	if arg.Value != nil && arg.Value.Position != nil {
+		pos = arg.Value.Position
+	} else {
+		pos = arg.Position
+	}
+
+	var out []ruleError
+	for _, problem := range checkRule(rule, tags, siblings) {
+		out = append(out, ruleError{pos: pos, message: fmt.Sprintf("@%s on %s: %s", directiveName, owner, problem)})
+	}
+	return out
+}
+
+// checkMessage reports the placeholders of a custom message that the runtime
+// cannot render.
+func checkMessage(message string) []string {
+	var problems []string
+	for _, match := range placeholderPattern.FindAllStringSubmatch(message, -1) {
+		if !placeholders.contains(match[1]) {
+			problems = append(problems, fmt.Sprintf("unknown placeholder %q in message", match[0]))
+		}
+	}
+	return problems
+}
+
+// messageProblems checks a custom message and reports every problem at the
+// position of its value.
+func messageProblems(owner string, value *ast.Value, message string) []ruleError {
+	var pos *ast.Position
+	if value != nil {
+		pos = value.Position
+	}
+
+	var out []ruleError
+	for _, problem := range checkMessage(message) {
+		out = append(out, ruleError{pos: pos, message: fmt.Sprintf("@%s on %s: %s", directiveName, owner, problem)})
+	}
+	return out
+}
+
+// joinRuleErrors orders the errors by schema position and joins them into one.
+func joinRuleErrors(errs []ruleError) error {
+	slices.SortStableFunc(errs, func(a, b ruleError) int {
+		return comparePositions(a.pos, b.pos)
+	})
+
+	out := make([]error, len(errs))
+	for i, err := range errs {
+		out[i] = err
+	}
+	return errors.Join(out...)
+}
+
+func comparePositions(a, b *ast.Position) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if a.Src != nil && b.Src != nil {
+		if c := strings.Compare(a.Src.Name, b.Src.Name); c != 0 {
+			return c
+		}
+	}
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+	return a.Column - b.Column
+}
+
+// checkFieldReferences ensures cross-field rules reference sibling fields.
+func checkFieldReferences(name, params string, siblings set) []string {
+	var refs []string
+	switch {
+	case crossFieldRules.contains(name):
+		refs = []string{params}
+	case multiFieldRules.contains(name):
+		refs = strings.Fields(params)
+	case pairedFieldRules.contains(name):
+		fields := strings.Fields(params)
+		if len(fields)%2 != 0 {
+			return []string{fmt.Sprintf("%s expects field/value pairs (got %q)", name, params)}
+		}
+		for i := 0; i < len(fields); i += 2 {
+			refs = append(refs, fields[i])
+		}
+	case crossFieldRelativeRules.contains(name):
+		if siblings == nil {
+			return []string{fmt.Sprintf("%s is not supported on arguments", name)}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	if siblings == nil {
+		return []string{fmt.Sprintf("%s is not supported on arguments", name)}
+	}
+	if len(refs) == 0 || slices.Contains(refs, "") {
+		return []string{fmt.Sprintf("%s requires a field name", name)}
+	}
+
+	var problems []string
+	for _, ref := range refs {
+		if !siblings.contains(ref) {
+			problems = append(problems, fmt.Sprintf("%s references unknown field %q", name, ref))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,99 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestCheckRule(t *testing.T) {
+	t.Parallel()
+
+	siblings := set{"password": {}, "email": {}, "phone": {}, "userOwned": {}}
+
+	cases := []struct {
+		name     string
+		rule     string
+		siblings set
+		expect   []string
+	}{
+		{name: "valid", rule: "required,min=8,max=64", siblings: siblings},
+		{name: "valid or", rule: "email|e164", siblings: siblings},
+		{name: "valid dive keys", rule: "dive,keys,min=1,endkeys,required", siblings: siblings},
+		{name: "valid cross-field", rule: "eqfield=password", siblings: siblings},
+		{name: "valid multi-field", rule: "required_without=email phone", siblings: siblings},
+		{name: "valid paired", rule: "required_if=userOwned false", siblings: siblings},
+		{name: "valid argument", rule: "omitempty,gte=1", siblings: nil},
+		{name: "valid params", rule: "len=0x10,gt=1.5,lt=1h,oneof=a b,eq=x", siblings: siblings},
+		{name: "missing param", rule: "min,oneof=", siblings: siblings, expect: []string{"min requires a parameter", "oneof requires a parameter"}},
+		{name: "missing param in or", rule: "email|startswith", siblings: siblings, expect: []string{"startswith requires a parameter"}},
+		{name: "non-numeric param", rule: "max=ten", siblings: siblings, expect: []string{`max requires a number (got "ten")`}},
+		{name: "unknown tag", rule: "requird,min=8", siblings: siblings, expect: []string{`unknown tag "requird"`}},
+		{name: "unknown in or", rule: "email|phone", siblings: siblings, expect: []string{`unknown tag "phone"`}},
+		{name: "empty tag", rule: "required,,min=1", siblings: siblings, expect: []string{"empty tag"}},
+		{name: "unknown field", rule: "eqfield=pasword", siblings: siblings, expect: []string{`eqfield references unknown field "pasword"`}},
+		{name: "missing field", rule: "eqfield", siblings: siblings, expect: []string{"eqfield requires a field name"}},
+		{
+			name:     "unknown multi-field",
+			rule:     "required_without=email fax,required_with=mobile",
+			siblings: siblings,
+			expect: []string{
+				`required_without references unknown field "fax"`,
+				`required_with references unknown field "mobile"`,
+			},
+		},
+		{name: "odd pairs", rule: "required_if=userOwned", siblings: siblings, expect: []string{`required_if expects field/value pairs (got "userOwned")`}},
+		{name: "cross-field on argument", rule: "eqfield=password", siblings: nil, expect: []string{"eqfield is not supported on arguments"}},
+		{name: "control tag in or", rule: "omitempty|email", siblings: siblings, expect: []string{`"omitempty" cannot be combined with other tags using '|'`}},
+		{name: "keys without dive", rule: "keys,min=1,endkeys", siblings: siblings, expect: []string{`"keys" must immediately follow "dive"`}},
+		{name: "endkeys without keys", rule: "dive,endkeys", siblings: siblings, expect: []string{`"endkeys" without a preceding "keys"`}},
+		{name: "unterminated keys", rule: "dive,keys,min=1", siblings: siblings, expect: []string{`"keys" without a matching "endkeys"`}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, checkRule(tc.rule, builtinTags, tc.siblings))
+		})
+	}
+}
+
+func TestCheckMessage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		message string
+		expect  []string
+	}{
+		{message: "plain text"},
+		{message: "{field} must be at least {param} characters (got {value})"},
+		{message: "{rule} failed at {path}"},
+		{message: "positional {0} and {1} are translation parameters"},
+		{message: "{ field } and {} are not placeholders"},
+		{message: "{fild} and {Param}", expect: []string{`unknown placeholder "{fild}" in message`, `unknown placeholder "{Param}" in message`}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.message, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, checkMessage(tc.message))
+		})
+	}
+}
+
+func TestRuleErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	src := &ast.Source{Name: "schema.graphql"}
+	errs := []ruleError{
+		{pos: &ast.Position{Src: src, Line: 7, Column: 3}, message: "second"},
+		{pos: &ast.Position{Src: src, Line: 2, Column: 9}, message: "first"},
+		{message: "no position"},
+	}
+
+	err := joinRuleErrors(errs)
+	require.Error(t, err)
+	assert.Equal(t, "no position\nschema.graphql:2:9: first\nschema.graphql:7:3: second", err.Error())
+}
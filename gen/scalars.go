@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// checkScalarRules expands the aliases of the default scalar rules and checks
+// them against the schema and the known tags. The rules are checked on their
+// own, so they cannot reference fields, and may not dive: the plugin adds the
+// dives for list fields itself.
+func (p *Plugin) checkScalarRules(schema *ast.Schema) []ruleError {
+	names := make([]string, 0, len(p.scalarRules))
+	for name := range p.scalarRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []ruleError
+	for _, name := range names {
+		rule := p.expandAliases(p.scalarRules[name])
+		p.scalarRules[name] = rule
+
+		var messages []string
+		switch def := schema.Types[name]; {
+		case def == nil:
+			messages = append(messages, "scalar is not defined")
+		case def.Kind != ast.Scalar:
+			messages = append(messages, fmt.Sprintf("%s is not a scalar", name))
+		}
+		if ruleTags(rule).contains(diveTag) {
+			messages = append(messages, fmt.Sprintf("default rules cannot use %q", diveTag))
+		}
+		messages = append(messages, checkRule(rule, p.tags, make(set))...)
+		messages = append(messages, p.aliasProblems(rule)...)
+
+		for _, message := range messages {
+			problems = append(problems, ruleError{message: fmt.Sprintf("default rule for scalar %q: %s", name, message)})
+		}
+	}
+	return problems
+}
+
+// scalarRule returns the default rule of a field of type typ, or false when its
+// scalar has none. Lists get one dive per level and nullable values are skipped
+// when nil.
+func (p *Plugin) scalarRule(typ *ast.Type) (string, bool) {
+	rule, ok := p.scalarRules[typ.Name()]
+	if !ok {
+		return "", false
+	}
+
+	var segments []string
+	for ; typ.Elem != nil; typ = typ.Elem {
+		segments = append(segments, diveTag)
+	}
+	if !typ.NonNull {
+		segments = append(segments, "omitnil")
+	}
+	return strings.Join(append(segments, rule), ","), true
+}
@@ -0,0 +1,79 @@
+package gen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// skipDirectiveName identifies the directive excluding a field or argument from
+// validation.
+const skipDirectiveName = "skipValidate"
+
+// skipRule is a resolver field, or some of its arguments, carrying @skipValidate.
+type skipRule struct {
+	Object    string
+	Field     string
+	Arguments []string
+}
+
+// hasSkip reports whether directives include @skipValidate, which may not be
+// combined with @validate on owner.
+func hasSkip(owner string, directives ast.DirectiveList) (bool, error) {
+	if directives.ForName(skipDirectiveName) == nil {
+		return false, nil
+	}
+	if directives.ForName(directiveName) != nil {
+		return false, fmt.Errorf("@%s and @%s cannot be combined (%s)", skipDirectiveName, directiveName, owner)
+	}
+	return true, nil
+}
+
+// collectSkips records the resolver fields and arguments of an object carrying
+// @skipValidate.
+func (p *Plugin) collectSkips(def *ast.Definition) error {
+	if def.BuiltIn {
+		return nil
+	}
+
+	for _, field := range def.Fields {
+		owner := def.Name + "." + field.Name
+		skip, err := hasSkip(owner, field.Directives)
+		if err != nil {
+			return err
+		}
+		if skip {
+			p.skips = append(p.skips, skipRule{Object: def.Name, Field: field.Name})
+			continue
+		}
+
+		var names []string
+		for _, arg := range field.Arguments {
+			skip, err := hasSkip(owner+"."+arg.Name, arg.Directives)
+			if err != nil {
+				return err
+			}
+			if skip {
+				names = append(names, arg.Name)
+			}
+		}
+		if len(names) > 0 {
+			p.skips = append(p.skips, skipRule{Object: def.Name, Field: field.Name, Arguments: names})
+		}
+	}
+	return nil
+}
+
+// sortSkips orders the skipped fields by object and field name.
+func sortSkips(skips []skipRule) []skipRule {
+	skips = slices.Clone(skips)
+	slices.SortStableFunc(skips, func(a, b skipRule) int {
+		if c := strings.Compare(a.Object, b.Object); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Field, b.Field)
+	})
+	return skips
+}
@@ -0,0 +1,170 @@
+package gen
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// sourceName names the schema source declaring the directives of the plugin.
+const sourceName = "gqlgen-validate.graphql"
+
+//go:embed directives.graphql
+var directivesSource string
+
+// messageTypeName identifies the input type of the messages argument.
+const messageTypeName = "ValidateMessage"
+
+// canonical holds the directives and types declared by directives.graphql.
+var canonical = func() *ast.SchemaDocument {
+	doc, err := parser.ParseSchema(&ast.Source{Name: sourceName, Input: directivesSource})
+	if err != nil {
+		panic(err)
+	}
+	return doc
+}()
+
+var _ plugin.EarlySourcesInjector = &Plugin{}
+
+// AddPlugin returns an api.Option adding the plugin, configured with opts, to
+// api.Generate. Unlike api.AddPlugin(New(opts...)), the plugin sees the schema
+// sources of the configuration and declares the directives they leave out.
+func AddPlugin(opts ...Option) api.Option {
+	return func(cfg *config.Config, plugins *[]plugin.Plugin) {
+		p := New(opts...).(*Plugin)
+		p.sources = cfg.Sources
+		*plugins = append(*plugins, p)
+	}
+}
+
+// InjectSourcesEarly implements plugin.EarlySourcesInjector. It declares the
+// directives of the plugin, and the ValidateMessage input of @validate, that the
+// schema does not declare itself. Declarations of the schema are kept when
+// their arguments are compatible with the plugin's, so existing schemas keep
+// working; incompatible ones are reported. Without the sources, as with
+// api.AddPlugin(New()), the schema must declare the directives it uses.
+func (p *Plugin) InjectSourcesEarly() ([]*ast.Source, error) {
+	if p.sources == nil {
+		return nil, nil
+	}
+
+	directives := make(map[string]*ast.DirectiveDefinition)
+	types := make(map[string]*ast.Definition)
+	for _, source := range p.sources {
+		if source.Name == sourceName {
+			continue
+		}
+		// Syntax errors are reported when gqlgen loads the schema.
+		doc, err := parser.ParseSchema(source)
+		if err != nil {
+			continue
+		}
+		for _, directive := range doc.Directives {
+			directives[directive.Name] = directive
+		}
+		for _, def := range doc.Definitions {
+			types[def.Name] = def
+		}
+	}
+
+	var problems []ruleError
+	out := &ast.SchemaDocument{}
+	for _, want := range canonical.Directives {
+		got, ok := directives[want.Name]
+		if !ok {
+			out.Directives = append(out.Directives, want)
+			continue
+		}
+		problems = append(problems, compareDirective(want, got, types)...)
+	}
+	if _, ok := types[messageTypeName]; !ok && directives[directiveName] == nil {
+		out.Definitions = append(out.Definitions, canonical.Definitions.ForName(messageTypeName))
+	}
+
+	if len(problems) > 0 {
+		return nil, joinRuleErrors(problems)
+	}
+	if len(out.Directives) == 0 && len(out.Definitions) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	formatter.NewFormatter(&b).FormatSchemaDocument(out)
+	return []*ast.Source{{Name: sourceName, Input: b.String()}}, nil
+}
+
+// compareDirective reports the arguments of a directive declared by the schema
+// that the plugin does not know or reads with another type. Arguments may be
+// left out, non-null or, for lists, declared as a single element, and the
+// declaration may allow fewer locations, as gqlparser rejects uses elsewhere.
+// It must be repeatable when the plugin's is.
+func compareDirective(want, got *ast.DirectiveDefinition, types map[string]*ast.Definition) []ruleError {
+	var problems []ruleError
+	if want.IsRepeatable && !got.IsRepeatable {
+		problems = append(problems, ruleError{pos: got.Position, message: fmt.Sprintf(
+			"@%s is not declared repeatable, but gqlgen-validate reads it repeatedly; add repeatable or remove the declaration to use the one of the plugin",
+			got.Name)})
+	}
+	for _, arg := range got.Arguments {
+		expected := want.Arguments.ForName(arg.Name)
+		switch {
+		case expected == nil:
+			problems = append(problems, ruleError{pos: arg.Position, message: fmt.Sprintf(
+				"@%s declares the argument %q, which gqlgen-validate does not support; remove it or the whole declaration to use the one of the plugin",
+				got.Name, arg.Name)})
+		case !compatibleType(expected.Type, arg.Type):
+			problems = append(problems, ruleError{pos: arg.Position, message: fmt.Sprintf(
+				"@%s declares %s as %s, but gqlgen-validate reads it as %s; fix the type or remove the declaration to use the one of the plugin",
+				got.Name, arg.Name, arg.Type.String(), expected.Type.String())})
+		case expected.Type.Name() == messageTypeName:
+			problems = append(problems, compareMessageType(types[messageTypeName])...)
+		}
+	}
+	return problems
+}
+
+// compareMessageType reports a ValidateMessage type declared by the schema that
+// is not an input with String fields known to the plugin.
+func compareMessageType(got *ast.Definition) []ruleError {
+	if got == nil {
+		return nil
+	}
+	if got.Kind != ast.InputObject {
+		return []ruleError{{pos: got.Position, message: fmt.Sprintf("%s must be an input object", messageTypeName)}}
+	}
+
+	want := canonical.Definitions.ForName(messageTypeName)
+	var problems []ruleError
+	for _, field := range got.Fields {
+		expected := want.Fields.ForName(field.Name)
+		if expected == nil || !compatibleType(expected.Type, field.Type) {
+			problems = append(problems, ruleError{pos: field.Position, message: fmt.Sprintf(
+				"%s.%s is not read by gqlgen-validate; messages have a locale, a rule and a text, all strings",
+				messageTypeName, field.Name)})
+		}
+	}
+	return problems
+}
+
+// compatibleType reports whether values of got are read like values of want:
+// nullability aside, the types must match, except that a list may be declared
+// as its element, which GraphQL coerces to a list of one.
+func compatibleType(want, got *ast.Type) bool {
+	switch {
+	case want.Elem == nil && got.Elem == nil:
+		return want.NamedType == got.NamedType
+	case want.Elem != nil && got.Elem != nil:
+		return compatibleType(want.Elem, got.Elem)
+	case want.Elem != nil:
+		return compatibleType(want.Elem, got)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,152 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestInjectSourcesEarly(t *testing.T) {
+	inject := func(t *testing.T, input string) ([]*ast.Source, error) {
+		t.Helper()
+		p := New().(*Plugin)
+		p.sources = []*ast.Source{{Name: "schema.graphql", Input: input}}
+		return p.InjectSourcesEarly()
+	}
+
+	t.Run("without sources", func(t *testing.T) {
+		sources, err := New().(*Plugin).InjectSourcesEarly()
+		require.NoError(t, err)
+		assert.Nil(t, sources)
+	})
+
+	t.Run("declares the directives", func(t *testing.T) {
+		input := `
+    scalar Map
+
+    extend schema @validateAlias(name: "short", rule: "max=8")
+
+    input TagInput @validateStruct(rule: "required_any=name labels") {
+        name: String @validate(rule: "short", messages: [{rule: "max", text: "too long"}])
+        labels: Map @validate(each: "min=1") @validateDefault(name: "empty")
+        note: String @skipValidate @validateTransform(ops: ["trim", "collapse"])
+    }
+
+    type Query {
+        tags(input: TagInput!): Int
+    }
+`
+		sources, err := inject(t, input)
+		require.NoError(t, err)
+		require.Len(t, sources, 1)
+		assert.Equal(t, sourceName, sources[0].Name)
+
+		schema, gqlErr := gqlparser.LoadSchema(append([]*ast.Source{{Name: "schema.graphql", Input: input}}, sources...)...)
+		require.NoError(t, gqlErr)
+		for _, name := range []string{directiveName, structDirectiveName, aliasDirectiveName, skipDirectiveName, transformDirectiveName, defaultDirectiveName} {
+			assert.Contains(t, schema.Directives, name)
+		}
+
+		plugin := New().(*Plugin)
+		require.NoError(t, plugin.MutateSchema(schema))
+		assert.Equal(t, "max=8", plugin.fieldRules["TagInput"]["name"])
+		assert.Equal(t, "dive,min=1", plugin.fieldRules["TagInput"]["labels"])
+	})
+
+	t.Run("keeps compatible declarations", func(t *testing.T) {
+		sources, err := inject(t, `
+    directive @validate(rule: String!, message: String, messages: ValidateMessage) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @skipValidate on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION | ENUM_VALUE
+    input ValidateMessage { locale: String!, text: String! }
+`)
+		require.NoError(t, err)
+		require.Len(t, sources, 1)
+		assert.NotContains(t, sources[0].Input, "@validate(")
+		assert.NotContains(t, sources[0].Input, "@skipValidate")
+		assert.NotContains(t, sources[0].Input, "ValidateMessage")
+		assert.Contains(t, sources[0].Input, "directive @validateStruct")
+		assert.Contains(t, sources[0].Input, "directive @validateAlias")
+	})
+
+	t.Run("ignores directives of the schema", func(t *testing.T) {
+		sources, err := inject(t, `
+    directive @transform(value: String) on FIELD_DEFINITION
+    directive @default(value: String) on INPUT_FIELD_DEFINITION
+`)
+		require.NoError(t, err)
+		require.Len(t, sources, 1)
+		assert.Contains(t, sources[0].Input, "directive @validateTransform")
+		assert.Contains(t, sources[0].Input, "directive @validateDefault")
+	})
+
+	t.Run("nothing to declare", func(t *testing.T) {
+		sources, err := inject(t, `
+    directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @validateStruct(rule: String!) repeatable on INPUT_OBJECT
+    directive @validateAlias(name: String!, rule: String!) repeatable on SCHEMA
+    directive @skipValidate on FIELD_DEFINITION | ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+    directive @validateTransform(ops: [String!]) on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+    directive @validateDefault(name: String!) on INPUT_FIELD_DEFINITION
+`)
+		require.NoError(t, err)
+		assert.Nil(t, sources)
+	})
+
+	t.Run("reports incompatible declarations", func(t *testing.T) {
+		_, err := inject(t, `
+    directive @validate(rule: Int!, each: [[String]], tags: [String!], messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    directive @validateStruct(rule: [String!]!) repeatable on INPUT_OBJECT
+    type ValidateMessage { text: String }
+`)
+		require.Error(t, err)
+		assert.Equal(t, strings.Join([]string{
+			`schema.graphql:2:25: @validate declares rule as Int!, but gqlgen-validate reads it as String; fix the type or remove the declaration to use the one of the plugin`,
+			`schema.graphql:2:37: @validate declares each as [[String]], but gqlgen-validate reads it as [String!]; fix the type or remove the declaration to use the one of the plugin`,
+			`schema.graphql:2:55: @validate declares the argument "tags", which gqlgen-validate does not support; remove it or the whole declaration to use the one of the plugin`,
+			`schema.graphql:3:31: @validateStruct declares rule as [String!]!, but gqlgen-validate reads it as String!; fix the type or remove the declaration to use the one of the plugin`,
+			`schema.graphql:4:10: ValidateMessage must be an input object`,
+		}, "\n"), err.Error())
+
+		_, err = inject(t, `
+    directive @validate(rule: String!, messages: [ValidateMessage!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION | FIELD_DEFINITION
+    input ValidateMessage { lang: String, text: String! }
+`)
+		assert.EqualError(t, err, `schema.graphql:3:29: ValidateMessage.lang is not read by gqlgen-validate; messages have a locale, a rule and a text, all strings`)
+	})
+
+	t.Run("keeps fewer locations", func(t *testing.T) {
+		// The declaration of earlier versions of the README.
+		sources, err := inject(t, `
+    directive @validate(rule: String!, message: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+    directive @skipValidate on ARGUMENT_DEFINITION
+`)
+		require.NoError(t, err)
+		require.Len(t, sources, 1)
+		assert.NotContains(t, sources[0].Input, "@validate(")
+		assert.NotContains(t, sources[0].Input, "@skipValidate")
+	})
+
+	t.Run("reports missing repeatable", func(t *testing.T) {
+		_, err := inject(t, `
+    directive @validateStruct(rule: String!) on INPUT_OBJECT
+`)
+		assert.EqualError(t, err, `schema.graphql:2:16: @validateStruct is not declared repeatable, but gqlgen-validate reads it repeatedly; add repeatable or remove the declaration to use the one of the plugin`)
+	})
+
+	t.Run("AddPlugin", func(t *testing.T) {
+		cfg := &config.Config{Sources: []*ast.Source{{Name: "schema.graphql", Input: "type Query { a: Int }"}}}
+		var plugins []plugin.Plugin
+		AddPlugin(WithStaticValidation())(cfg, &plugins)
+
+		require.Len(t, plugins, 1)
+		p := plugins[0].(*Plugin)
+		assert.True(t, p.static)
+		assert.Equal(t, cfg.Sources, p.sources)
+	})
+}
@@ -0,0 +1,911 @@
+package gen
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+//go:embed validate.gotpl
+var validateTemplate string
+
+// staticTags lists the tags WithStaticValidation compiles to Go code. It must
+// match the list kept by the runtime package.
+var staticTags = set{
+	"-": {}, "required": {}, "omitempty": {}, "omitnil": {}, "dive": {},
+	"min": {}, "max": {}, "len": {}, "eq": {}, "ne": {}, "gt": {}, "gte": {}, "lt": {}, "lte": {},
+	"oneof": {}, "eqfield": {}, "nefield": {},
+	"required_with": {}, "required_with_all": {}, "required_without": {}, "required_without_all": {},
+	"excluded_with": {}, "excluded_with_all": {}, "excluded_without": {}, "excluded_without_all": {},
+	"alpha": {}, "alphanum": {}, "numeric": {}, "number": {}, "hexadecimal": {}, "email": {},
+	"e164": {}, "uuid": {}, "uuid4": {},
+}
+
+// formatTags are the string formats checked with runtime.MatchFormat.
+var formatTags = set{
+	"alpha": {}, "alphanum": {}, "numeric": {}, "number": {}, "hexadecimal": {}, "email": {},
+	"e164": {}, "uuid": {}, "uuid4": {},
+}
+
+// runWhenNilTags are evaluated by go-playground even when the field is a nil
+// pointer; any other tag fails on nil.
+var runWhenNilTags = set{
+	"required_with": {}, "required_with_all": {}, "required_without": {}, "required_without_all": {},
+	"excluded_with": {}, "excluded_with_all": {}, "excluded_without": {}, "excluded_without_all": {},
+}
+
+// errUnsupported marks rules the static generator cannot compile. Types using
+// them keep being validated by go-playground.
+var errUnsupported = errors.New("not supported by static validation")
+
+// staticType is a validated input type with its generated field checks.
+type staticType struct {
+	Name string
+	Body string
+}
+
+// tagCall is a single validator tag with its parameter.
+type tagCall struct {
+	name  string
+	param string
+}
+
+// operand is a value checked by the generated code.
+type operand struct {
+	expr    string     // expression of the value
+	ref     string     // expression to call methods on, when it differs from expr
+	typ     types.Type // type of expr
+	pointer bool       // the value was reached through a non-nil pointer
+	isNil   bool       // the value is a nil pointer
+	ns      string     // expression building the GraphQL namespace
+	sns     string     // expression building the Go namespace
+}
+
+// staticGenerator compiles the rules of validated input types into Go code that
+// reports the same errors as go-playground.
+type staticGenerator struct {
+	objects    map[string]*codegen.Object // input objects keyed by GraphQL name
+	byType     map[string]*codegen.Object // input objects keyed by Go type
+	rules      map[string]map[string]string
+	structs    map[string][]structRule
+	markers    set
+	candidates set
+	vars       int
+}
+
+func newStaticGenerator(inputs codegen.Objects, rules map[string]map[string]string, markers set) *staticGenerator {
+	g := &staticGenerator{
+		objects:    make(map[string]*codegen.Object, len(inputs)),
+		byType:     make(map[string]*codegen.Object, len(inputs)),
+		rules:      rules,
+		markers:    markers,
+		candidates: make(set),
+	}
+	for _, obj := range inputs {
+		g.objects[obj.Name] = obj
+		g.byType[types.TypeString(obj.Type, nil)] = obj
+		if markers.contains(obj.Name) {
+			g.candidates.add(obj.Name)
+		}
+	}
+	return g
+}
+
+// staticTypes generates the checks of every marker type whose rules, and those
+// of the marker types it contains, are supported. Types are dropped until the
+// remaining ones only reference each other.
+func (g *staticGenerator) staticTypes() []staticType {
+	for {
+		names := g.candidates.values()
+		sort.Strings(names)
+
+		out := make([]staticType, 0, len(names))
+		changed := false
+		for _, name := range names {
+			obj := g.objects[name]
+			body, err := g.generate(obj)
+			if err != nil {
+				delete(g.candidates, name)
+				changed = true
+				continue
+			}
+			out = append(out, staticType{Name: goTypeName(obj.Type), Body: body})
+		}
+		if !changed {
+			return out
+		}
+	}
+}
+
+// generate returns the statements validating the fields of obj.
+func (g *staticGenerator) generate(obj *codegen.Object) (string, error) {
+	g.vars = 0
+
+	var b strings.Builder
+	for _, field := range obj.Fields {
+		if field.TypeReference == nil || g.rules[obj.Name][field.Name] == skipTag {
+			continue
+		}
+
+		tags, err := parseStaticRule(g.rules[obj.Name][field.Name])
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+
+		code, err := g.value(obj, operand{
+			expr: "m." + field.GoFieldName,
+			typ:  field.TypeReference.GO,
+			ns:   "ns + " + strconv.Quote("."+field.Name),
+			sns:  "sns + " + strconv.Quote("."+field.GoFieldName),
+		}, tags)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", obj.Name, field.Name, err)
+		}
+		b.WriteString(code)
+	}
+
+	// Like go-playground, struct rules run after the fields.
+	for _, rule := range g.structs[obj.Name] {
+		if rule.Name != oneOfRule {
+			return "", fmt.Errorf("%s: struct rule %q: %w", obj.Name, rule.Name, errUnsupported)
+		}
+		b.WriteString(g.oneOf(obj, rule))
+	}
+	return b.String(), nil
+}
+
+// oneOf counts the fields of a OneOf input object that are not nil and reports
+// the rule on the object unless there is exactly one.
+func (g *staticGenerator) oneOf(obj *codegen.Object, rule structRule) string {
+	var b strings.Builder
+	b.WriteString("{\nset := 0\n")
+	for _, field := range obj.Fields {
+		if field.TypeReference == nil {
+			continue
+		}
+		if isPointer(field.TypeReference.GO) || isNilable(field.TypeReference.GO) {
+			fmt.Fprintf(&b, "if m.%s != nil {\nset++\n}\n", field.GoFieldName)
+		} else {
+			b.WriteString("set++\n")
+		}
+	}
+	b.WriteString("if set != 1 {\n")
+	b.WriteString(errorLine(operand{expr: "m", ns: `ns + "."`, sns: `sns + "."`}, rule.Name, rule.Param))
+	b.WriteString("}\n}\n")
+	return b.String()
+}
+
+// parseStaticRule splits a rule in Go casing into its comma separated segments,
+// each holding one tag or the alternatives of a '|' group.
+func parseStaticRule(rule string) ([][]tagCall, error) {
+	if rule == "" {
+		return nil, nil
+	}
+
+	var out [][]tagCall
+	for _, segment := range strings.Split(rule, ",") {
+		var alternatives []tagCall
+		for _, alternative := range strings.Split(segment, "|") {
+			name, param, _ := strings.Cut(strings.TrimSpace(alternative), "=")
+			if !staticTags.contains(name) {
+				return nil, fmt.Errorf("tag %q: %w", name, errUnsupported)
+			}
+			alternatives = append(alternatives, tagCall{name: name, param: param})
+		}
+		if len(alternatives) > 1 {
+			for _, alternative := range alternatives {
+				if controlTags.contains(alternative.name) || runWhenNilTags.contains(alternative.name) {
+					return nil, fmt.Errorf("tag %q in '|' group: %w", alternative.name, errUnsupported)
+				}
+			}
+		}
+		out = append(out, alternatives)
+	}
+	return out, nil
+}
+
+// value checks op against tags, handling nil pointers the way go-playground
+// does before the tags run.
+func (g *staticGenerator) value(obj *codegen.Object, op operand, tags [][]tagCall) (string, error) {
+	switch t := op.typ.(type) {
+	case *types.Pointer:
+		if _, ok := t.Elem().(*types.Pointer); ok {
+			return "", fmt.Errorf("pointer to pointer: %w", errUnsupported)
+		}
+
+		nilCode, err := g.nilChain(obj, operand{expr: op.expr, typ: op.typ, isNil: true, ns: op.ns, sns: op.sns}, tags)
+		if err != nil {
+			return "", err
+		}
+		body, err := g.chain(obj, operand{expr: "*" + op.expr, ref: op.expr, typ: t.Elem(), pointer: true, ns: op.ns, sns: op.sns}, tags)
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case nilCode == "" && body == "":
+			return "", nil
+		case body == "":
+			return fmt.Sprintf("if %s == nil {\n%s}\n", op.expr, nilCode), nil
+		case nilCode == "":
+			return fmt.Sprintf("if %s != nil {\n%s}\n", op.expr, body), nil
+		case strings.HasPrefix(body, "if "):
+			return fmt.Sprintf("if %s == nil {\n%s} else %s", op.expr, nilCode, body), nil
+		default:
+			return fmt.Sprintf("if %s == nil {\n%s} else {\n%s}\n", op.expr, nilCode, body), nil
+		}
+	case *types.Interface:
+		if len(tags) > 0 {
+			return "", fmt.Errorf("interface value: %w", errUnsupported)
+		}
+		return "", nil
+	}
+	return g.chain(obj, op, tags)
+}
+
+// nilChain checks a nil pointer. The first tag fails unless it is an omit tag or
+// one of the tags that go-playground evaluates on nil values.
+func (g *staticGenerator) nilChain(obj *codegen.Object, op operand, tags [][]tagCall) (string, error) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+	if len(tags[0]) > 1 {
+		return "", fmt.Errorf("'|' group on a nil pointer: %w", errUnsupported)
+	}
+
+	first := tags[0][0]
+	switch {
+	case first.name == "omitempty" || first.name == "omitnil":
+		return "", nil
+	case !runWhenNilTags.contains(first.name):
+		return errorLine(op, first.name, first.param), nil
+	}
+	return g.chain(obj, op, tags)
+}
+
+// chain checks op against tags in order. Like go-playground it stops at the
+// first failing tag of the value and validates nested structs last.
+func (g *staticGenerator) chain(obj *codegen.Object, op operand, tags [][]tagCall) (string, error) {
+	if len(tags) == 0 {
+		return g.nested(op)
+	}
+
+	segment, rest := tags[0], tags[1:]
+	if len(segment) > 1 {
+		return g.orGroup(obj, op, segment, rest)
+	}
+
+	tag := segment[0]
+	switch tag.name {
+	case "omitempty", "omitnil":
+		has, err := hasValue(op)
+		if err != nil {
+			return "", err
+		}
+		if tag.name == "omitnil" && !isNilable(op.typ) {
+			has = "true"
+		}
+		if op.isNil || has == "false" {
+			return "", nil
+		}
+
+		code, err := g.chain(obj, op, rest)
+		if err != nil || code == "" || has == "true" {
+			return code, err
+		}
+		return fmt.Sprintf("if %s {\n%s}\n", has, code), nil
+	case "dive":
+		return g.dive(obj, op, rest)
+	}
+
+	fails, err := g.check(obj, op, tag)
+	if err != nil {
+		return "", err
+	}
+	return g.clause(obj, op, fails, tag.name, tag.param, rest)
+}
+
+// orGroup checks a '|' group, which fails when every alternative fails. The
+// error carries all alternatives as its tag and the last parameter.
+func (g *staticGenerator) orGroup(obj *codegen.Object, op operand, alternatives []tagCall, rest [][]tagCall) (string, error) {
+	if op.isNil {
+		return "", fmt.Errorf("'|' group on a nil pointer: %w", errUnsupported)
+	}
+
+	conds := make([]string, 0, len(alternatives))
+	names := make([]string, 0, len(alternatives))
+	for _, alternative := range alternatives {
+		fails, err := g.check(obj, op, alternative)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, fails)
+
+		name := alternative.name
+		if alternative.param != "" {
+			name += "=" + alternative.param
+		}
+		names = append(names, name)
+	}
+
+	return g.clause(obj, op, and(conds...), strings.Join(names, "|"), alternatives[len(alternatives)-1].param, rest)
+}
+
+// clause reports tag when fails holds and checks the remaining tags otherwise.
+func (g *staticGenerator) clause(obj *codegen.Object, op operand, fails, tag, param string, rest [][]tagCall) (string, error) {
+	line := errorLine(op, tag, param)
+	if fails == "true" {
+		return line, nil
+	}
+
+	code, err := g.chain(obj, op, rest)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case fails == "false":
+		return code, nil
+	case code == "":
+		return fmt.Sprintf("if %s {\n%s}\n", fails, line), nil
+	case strings.HasPrefix(code, "if "):
+		return fmt.Sprintf("if %s {\n%s} else %s", fails, line, code), nil
+	default:
+		return fmt.Sprintf("if %s {\n%s} else {\n%s}\n", fails, line, code), nil
+	}
+}
+
+// dive checks every element of a slice against the remaining tags.
+func (g *staticGenerator) dive(obj *codegen.Object, op operand, rest [][]tagCall) (string, error) {
+	if op.isNil {
+		return "", fmt.Errorf("dive on a nil pointer: %w", errUnsupported)
+	}
+	slice, ok := op.typ.Underlying().(*types.Slice)
+	if !ok {
+		return "", fmt.Errorf("dive on %s: %w", op.typ, errUnsupported)
+	}
+
+	g.vars++
+	i, e := fmt.Sprintf("i%d", g.vars), fmt.Sprintf("e%d", g.vars)
+	body, err := g.value(obj, operand{expr: e, typ: slice.Elem(), ns: indexed(op.ns, i), sns: indexed(op.sns, i)}, rest)
+	if err != nil || body == "" {
+		return "", err
+	}
+	return fmt.Sprintf("for %s, %s := range %s {\n%s}\n", i, e, op.expr, body), nil
+}
+
+// nested validates the fields of a struct value once its own tags passed.
+func (g *staticGenerator) nested(op operand) (string, error) {
+	if op.isNil {
+		return "", nil
+	}
+	if _, ok := op.typ.Underlying().(*types.Struct); !ok {
+		return "", nil
+	}
+
+	obj, ok := g.byType[types.TypeString(op.typ, nil)]
+	if !ok {
+		return "", nil
+	}
+	if !g.markers.contains(obj.Name) {
+		if g.containsMarkers(obj, make(set)) {
+			return "", fmt.Errorf("%s contains validated inputs: %w", obj.Name, errUnsupported)
+		}
+		return "", nil
+	}
+	if !g.candidates.contains(obj.Name) {
+		return "", fmt.Errorf("%s: %w", obj.Name, errUnsupported)
+	}
+
+	ref := op.ref
+	if ref == "" {
+		ref = op.expr
+	}
+	return fmt.Sprintf("errs = append(errs, %s.validateFields(%s, %s)...)\n", ref, op.ns, op.sns), nil
+}
+
+// containsMarkers reports whether obj contains validated inputs at any depth.
+func (g *staticGenerator) containsMarkers(obj *codegen.Object, seen set) bool {
+	if seen.contains(obj.Name) {
+		return false
+	}
+	seen.add(obj.Name)
+
+	for _, field := range obj.Fields {
+		if field.TypeReference == nil {
+			continue
+		}
+		nested, ok := g.byType[types.TypeString(baseType(field.TypeReference.GO), nil)]
+		if !ok {
+			continue
+		}
+		if g.markers.contains(nested.Name) || g.containsMarkers(nested, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns the condition under which tag fails for op.
+func (g *staticGenerator) check(obj *codegen.Object, op operand, tag tagCall) (string, error) {
+	if runWhenNilTags.contains(tag.name) {
+		return g.checkPresence(obj, op, tag)
+	}
+
+	if tag.name == "required" {
+		has, err := hasValue(op)
+		if err != nil {
+			return "", err
+		}
+		return not(has), nil
+	}
+	if op.isNil {
+		return "", fmt.Errorf("tag %q on a nil pointer: %w", tag.name, errUnsupported)
+	}
+
+	switch tag.name {
+	case "min", "gte":
+		return g.compare(op, tag, "<")
+	case "max", "lte":
+		return g.compare(op, tag, ">")
+	case "gt":
+		return g.compare(op, tag, "<=")
+	case "lt":
+		return g.compare(op, tag, ">=")
+	case "len":
+		return g.compare(op, tag, "!=")
+	case "eq", "ne":
+		return g.equal(op, tag)
+	case "oneof":
+		return oneOf(op, tag)
+	case "eqfield", "nefield":
+		return g.compareField(obj, op, tag)
+	}
+
+	if formatTags.contains(tag.name) {
+		if !isString(op.typ) {
+			return "", fmt.Errorf("tag %q on %s: %w", tag.name, op.typ, errUnsupported)
+		}
+		return fmt.Sprintf("!runtime.MatchFormat(%q, %s)", tag.name, stringExpr(op)), nil
+	}
+	return "", fmt.Errorf("tag %q: %w", tag.name, errUnsupported)
+}
+
+// compare checks the length of strings and collections, or the value of
+// numbers, against the tag parameter. op is the operator under which it fails.
+func (g *staticGenerator) compare(op operand, tag tagCall, operator string) (string, error) {
+	switch {
+	case isString(op.typ):
+		n, err := strconv.ParseInt(tag.param, 0, 64)
+		if err != nil {
+			return "", fmt.Errorf("tag %q: %w", tag.name, errUnsupported)
+		}
+		return fmt.Sprintf("utf8.RuneCountInString(%s) %s %d", stringExpr(op), operator, n), nil
+	case isCollection(op.typ):
+		n, err := strconv.ParseInt(tag.param, 0, 64)
+		if err != nil {
+			return "", fmt.Errorf("tag %q: %w", tag.name, errUnsupported)
+		}
+		return fmt.Sprintf("len(%s) %s %d", op.expr, operator, n), nil
+	}
+
+	literal, err := numberLiteral(op.typ, tag.param)
+	if err != nil {
+		return "", fmt.Errorf("tag %q on %s: %w", tag.name, op.typ, err)
+	}
+	return fmt.Sprintf("%s %s %s", op.expr, operator, literal), nil
+}
+
+// equal implements eq and ne: strings and booleans compare their value,
+// collections their length.
+func (g *staticGenerator) equal(op operand, tag tagCall) (string, error) {
+	operator := "!="
+	if tag.name == "ne" {
+		operator = "=="
+	}
+
+	switch {
+	case isString(op.typ):
+		return fmt.Sprintf("%s %s %q", op.expr, operator, tag.param), nil
+	case isBool(op.typ):
+		b, err := strconv.ParseBool(tag.param)
+		if err != nil {
+			return "", fmt.Errorf("tag %q: %w", tag.name, errUnsupported)
+		}
+		if b == (tag.name == "eq") {
+			return "!" + op.expr, nil
+		}
+		return op.expr, nil
+	}
+	return g.compare(op, tag, operator)
+}
+
+// oneOf fails when the value is none of the space separated parameters.
+func oneOf(op operand, tag tagCall) (string, error) {
+	values := splitParams(tag.param)
+
+	var conds []string
+	switch {
+	case isString(op.typ):
+		for _, value := range values {
+			conds = append(conds, fmt.Sprintf("%s != %q", op.expr, value))
+		}
+	case isInteger(op.typ):
+		for _, value := range values {
+			// go-playground compares the decimal form, so "01" never matches.
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil && strconv.FormatInt(n, 10) == value {
+				if isUnsigned(op.typ) && n < 0 {
+					continue
+				}
+				conds = append(conds, fmt.Sprintf("%s != %s", op.expr, value))
+			}
+		}
+	default:
+		return "", fmt.Errorf("oneof on %s: %w", op.typ, errUnsupported)
+	}
+	return and(conds...), nil
+}
+
+// compareField implements eqfield and nefield against a sibling field of the
+// same type.
+func (g *staticGenerator) compareField(obj *codegen.Object, op operand, tag tagCall) (string, error) {
+	sibling := siblingField(obj, tag.param)
+	if sibling == nil || !types.Identical(sibling.TypeReference.GO, op.typ) {
+		return "", fmt.Errorf("tag %q: %w", tag.name, errUnsupported)
+	}
+
+	operator := "!="
+	if tag.name == "nefield" {
+		operator = "=="
+	}
+	other := "m." + sibling.GoFieldName
+
+	switch {
+	case isString(op.typ) || isBool(op.typ) || isNumber(op.typ):
+		return fmt.Sprintf("%s %s %s", op.expr, operator, other), nil
+	case isCollection(op.typ):
+		return fmt.Sprintf("len(%s) %s len(%s)", op.expr, operator, other), nil
+	}
+	return "", fmt.Errorf("tag %q on %s: %w", tag.name, op.typ, errUnsupported)
+}
+
+// checkPresence implements the required_with*, required_without*,
+// excluded_with* and excluded_without* tags.
+func (g *staticGenerator) checkPresence(obj *codegen.Object, op operand, tag tagCall) (string, error) {
+	has, err := hasValue(op)
+	if err != nil {
+		return "", err
+	}
+
+	names := splitParams(tag.param)
+	if tag.name == "excluded_without" {
+		names = []string{strings.TrimSpace(tag.param)}
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("tag %q without fields: %w", tag.name, errUnsupported)
+	}
+
+	empty := make([]string, 0, len(names))
+	present := make([]string, 0, len(names))
+	for _, name := range names {
+		sibling := siblingField(obj, name)
+		if sibling == nil {
+			return "", fmt.Errorf("tag %q references unknown field %q: %w", tag.name, name, errUnsupported)
+		}
+		e, p, err := emptiness("m."+sibling.GoFieldName, sibling.TypeReference.GO)
+		if err != nil {
+			return "", err
+		}
+		empty = append(empty, e)
+		present = append(present, p)
+	}
+
+	switch tag.name {
+	case "required_with":
+		return and(or(present...), not(has)), nil
+	case "required_with_all":
+		return and(append(present, not(has))...), nil
+	case "required_without":
+		return and(or(empty...), not(has)), nil
+	case "required_without_all":
+		return and(append(empty, not(has))...), nil
+	case "excluded_with":
+		return and(or(present...), has), nil
+	case "excluded_with_all":
+		return and(append(present, has)...), nil
+	case "excluded_without":
+		return and(empty[0], has), nil
+	default: // excluded_without_all
+		return and(append(empty, has)...), nil
+	}
+}
+
+// hasValue returns the condition under which go-playground considers op set.
+func hasValue(op operand) (string, error) {
+	switch {
+	case op.isNil:
+		return "false", nil
+	case isNilable(op.typ):
+		return op.expr + " != nil", nil
+	case op.pointer:
+		return "true", nil
+	case isString(op.typ):
+		return op.expr + ` != ""`, nil
+	case isNumber(op.typ):
+		return op.expr + " != 0", nil
+	case isBool(op.typ):
+		return op.expr, nil
+	}
+	return "", fmt.Errorf("presence of %s: %w", op.typ, errUnsupported)
+}
+
+// emptiness returns the conditions under which a sibling field is empty and
+// present for the cross-field presence tags.
+func emptiness(expr string, typ types.Type) (string, string, error) {
+	switch {
+	case isNilable(typ) || isPointer(typ):
+		return expr + " == nil", expr + " != nil", nil
+	case isString(typ):
+		return expr + ` == ""`, expr + ` != ""`, nil
+	case isNumber(typ):
+		return expr + " == 0", expr + " != 0", nil
+	case isBool(typ):
+		return "!" + expr, expr, nil
+	}
+	return "", "", fmt.Errorf("presence of %s: %w", typ, errUnsupported)
+}
+
+func errorLine(op operand, tag, param string) string {
+	return fmt.Sprintf("errs = append(errs, runtime.NewFieldError(%q, %q, %s, %s, %s))\n", tag, param, op.ns, op.sns, op.expr)
+}
+
+// indexed appends the index i to a namespace expression, which always ends with
+// a string literal.
+func indexed(ns, i string) string {
+	return strings.TrimSuffix(ns, `"`) + `[" + strconv.Itoa(` + i + `) + "]"`
+}
+
+func siblingField(obj *codegen.Object, goName string) *codegen.Field {
+	for _, field := range obj.Fields {
+		if field.GoFieldName == goName && field.TypeReference != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// splitParams splits a parameter list on spaces; single quotes group values
+// containing spaces, as in go-playground.
+func splitParams(param string) []string {
+	var out []string
+	for param = strings.TrimSpace(param); param != ""; param = strings.TrimSpace(param) {
+		if param[0] == '\'' {
+			if end := strings.IndexByte(param[1:], '\''); end >= 0 {
+				out = append(out, param[1:end+1])
+				param = param[end+2:]
+				continue
+			}
+		}
+		end := strings.IndexAny(param, " \t\n")
+		if end < 0 {
+			end = len(param)
+		}
+		out = append(out, strings.ReplaceAll(param[:end], "'", ""))
+		param = param[end:]
+	}
+	return out
+}
+
+// numberLiteral parses param as a constant of the numeric type typ.
+func numberLiteral(typ types.Type, param string) (string, error) {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || types.TypeString(typ, nil) == "time.Duration" {
+		return "", errUnsupported
+	}
+
+	info := basic.Info()
+	switch {
+	case info&types.IsUnsigned != 0:
+		n, err := strconv.ParseUint(param, 0, 64)
+		if err != nil {
+			return "", errUnsupported
+		}
+		return strconv.FormatUint(n, 10), nil
+	case info&types.IsInteger != 0:
+		n, err := strconv.ParseInt(param, 0, 64)
+		if err != nil {
+			return "", errUnsupported
+		}
+		return strconv.FormatInt(n, 10), nil
+	case info&types.IsFloat != 0:
+		bits := 64
+		if basic.Kind() == types.Float32 {
+			bits = 32
+		}
+		f, err := strconv.ParseFloat(param, bits)
+		if err != nil {
+			return "", errUnsupported
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", errUnsupported
+		}
+		return strconv.FormatFloat(f, 'g', -1, bits), nil
+	}
+	return "", errUnsupported
+}
+
+// stringExpr converts named string types so they can be passed to functions
+// taking a string.
+func stringExpr(op operand) string {
+	if _, ok := op.typ.(*types.Basic); ok {
+		return op.expr
+	}
+	return "string(" + op.expr + ")"
+}
+
+func and(conds ...string) string {
+	var parts []string
+	for _, cond := range conds {
+		switch cond {
+		case "true":
+			continue
+		case "false":
+			return "false"
+		}
+		parts = append(parts, cond)
+	}
+	switch len(parts) {
+	case 0:
+		return "true"
+	case 1:
+		return parts[0]
+	}
+	for i, part := range parts {
+		parts[i] = paren(part)
+	}
+	return strings.Join(parts, " && ")
+}
+
+func or(conds ...string) string {
+	var parts []string
+	for _, cond := range conds {
+		switch cond {
+		case "false":
+			continue
+		case "true":
+			return "true"
+		}
+		parts = append(parts, cond)
+	}
+	switch len(parts) {
+	case 0:
+		return "false"
+	case 1:
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, " || ") + ")"
+}
+
+func not(cond string) string {
+	switch cond {
+	case "true":
+		return "false"
+	case "false":
+		return "true"
+	}
+	if strings.HasSuffix(cond, " != nil") {
+		return strings.TrimSuffix(cond, " != nil") + " == nil"
+	}
+	if expr, ok := strings.CutSuffix(cond, ` != ""`); ok {
+		return expr + ` == ""`
+	}
+	if expr, ok := strings.CutSuffix(cond, " != 0"); ok {
+		return expr + " == 0"
+	}
+	return "!" + paren(cond)
+}
+
+// paren wraps conditions binding looser than &&.
+func paren(cond string) string {
+	if strings.Contains(cond, " || ") && !strings.HasPrefix(cond, "(") {
+		return "(" + cond + ")"
+	}
+	return cond
+}
+
+func baseType(t types.Type) types.Type {
+	for {
+		switch u := t.(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+func goTypeName(t types.Type) string {
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return types.TypeString(t, nil)
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.(*types.Pointer)
+	return ok
+}
+
+func isNilable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	}
+	return false
+}
+
+func isCollection(t types.Type) bool {
+	return isNilable(t)
+}
+
+func basicInfo(t types.Type) types.BasicInfo {
+	if basic, ok := t.Underlying().(*types.Basic); ok {
+		return basic.Info()
+	}
+	return 0
+}
+
+func isString(t types.Type) bool   { return basicInfo(t)&types.IsString != 0 }
+func isBool(t types.Type) bool     { return basicInfo(t)&types.IsBoolean != 0 }
+func isNumber(t types.Type) bool   { return basicInfo(t)&types.IsNumeric != 0 }
+func isInteger(t types.Type) bool  { return basicInfo(t)&types.IsInteger != 0 }
+func isUnsigned(t types.Type) bool { return basicInfo(t)&types.IsUnsigned != 0 }
+
+// generateStatic writes validate_gen.go, or removes it when no type qualifies.
+func (p *Plugin) generateStatic(cfg *codegen.Data) error {
+	filename := filepath.Join(filepath.Dir(cfg.Config.Model.Filename), "validate_gen.go")
+
+	var inputs codegen.Objects
+	for _, obj := range cfg.Inputs {
+		named, ok := obj.Type.(*types.Named)
+		if ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == cfg.Config.Model.ImportPath() {
+			inputs = append(inputs, obj)
+		}
+	}
+
+	var staticTypes []staticType
+	if p.static {
+		g := newStaticGenerator(inputs, p.fieldRules, p.markerTypes)
+		g.structs = p.structRules
+		staticTypes = g.staticTypes()
+	}
+	if len(staticTypes) == 0 {
+		_ = os.Remove(filename)
+		return nil
+	}
+
+	return renderStatic(cfg.Config, filename, staticTypes)
+}
+
+func renderStatic(cfg *config.Config, filename string, staticTypes []staticType) error {
+	return templates.Render(templates.Options{
+		PackageName:     cfg.Model.Package,
+		Filename:        filename,
+		Template:        validateTemplate,
+		Data:            struct{ Types []staticType }{Types: staticTypes},
+		Packages:        cfg.Packages,
+		GeneratedHeader: true,
+	})
+}
@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gqlast "github.com/vektah/gqlparser/v2/ast"
+)
+
+var update = flag.Bool("update", false, "rewrite the generated files of the test fixtures")
+
+// fixtureStructRules are the struct rules of the statictest models.
+var fixtureStructRules = map[string][]structRule{
+	"ChoiceInput": {{Name: oneOfRule, Param: "id text tags"}},
+}
+
+func TestStaticValidationGolden(t *testing.T) {
+	inputs, rules, markers := loadStaticFixture(t)
+
+	g := newStaticGenerator(inputs, rules, markers)
+	g.structs = fixtureStructRules
+	staticTypes := g.staticTypes()
+
+	names := make([]string, 0, len(staticTypes))
+	for _, staticType := range staticTypes {
+		names = append(names, staticType.Name)
+	}
+	assert.Equal(t, []string{"ChoiceInput", "NestedInput", "StaticInput"}, names)
+
+	tmpDir := t.TempDir()
+	cfg := newCodegenConfig(t, filepath.Join(tmpDir, "models_gen.go"))
+	cfg.Model.Package = "statictest"
+
+	filename := filepath.Join(tmpDir, "validate_gen.go")
+	require.NoError(t, renderStatic(cfg, filename, staticTypes))
+
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	golden := filepath.Join("internal", "statictest", "validate_gen.go")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, content, 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(content), "run go test ./gen -update to refresh %s", golden)
+}
+
+func TestStaticValidationUnsupported(t *testing.T) {
+	inputs, rules, markers := loadStaticFixture(t)
+	g := newStaticGenerator(inputs, rules, markers)
+	g.staticTypes()
+
+	_, err := g.generate(g.objects["DynamicInput"])
+	require.ErrorIs(t, err, errUnsupported)
+	assert.Contains(t, err.Error(), `tag "url"`)
+
+	_, err = g.generate(g.objects["WrapperInput"])
+	require.ErrorIs(t, err, errUnsupported)
+	assert.Contains(t, err.Error(), "DynamicInput")
+
+	g.structs = map[string][]structRule{"NestedInput": {{Name: "required_any", Param: "value uuid"}}}
+	_, err = g.generate(g.objects["NestedInput"])
+	require.ErrorIs(t, err, errUnsupported)
+	assert.Contains(t, err.Error(), `struct rule "required_any"`)
+}
+
+func TestPluginGenerateStatic(t *testing.T) {
+	t.Run("removes stale file when disabled", func(t *testing.T) {
+		plugin := &Plugin{markerTypes: set{"AlphaInput": {}}}
+		tmpDir := t.TempDir()
+		modelPath := filepath.Join(tmpDir, "models_gen.go")
+		createConfigPackage(t, modelPath)
+
+		filename := filepath.Join(tmpDir, "validate_gen.go")
+		require.NoError(t, os.WriteFile(filename, []byte("stale"), 0o600))
+
+		data := &codegen.Data{Config: newCodegenConfig(t, modelPath)}
+
+		require.NoError(t, plugin.GenerateCode(data))
+		_, err := os.Stat(filename)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("records field rules", func(t *testing.T) {
+		schema := mustLoadSchema(t, `
+directive @validate(rule: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+input ProxyInput {
+  name: String! @validate(rule: "required,max=3")
+  port: Int @validate(rule: "omitempty,oneof=80 443")
+}
+`)
+
+		plugin := New(WithStaticValidation()).(*Plugin)
+		require.NoError(t, plugin.MutateSchema(schema))
+
+		assert.True(t, plugin.static)
+		assert.Equal(t, map[string]map[string]string{
+			"ProxyInput": {"name": "required,max=3", "port": "omitempty,oneof=80 443"},
+		}, plugin.fieldRules)
+	})
+}
+
+func TestStaticTagsAreBuiltin(t *testing.T) {
+	for tag := range staticTags {
+		assert.True(t, builtinTags.contains(tag), tag)
+	}
+}
+
+func TestSplitParams(t *testing.T) {
+	assert.Equal(t, []string{"admin", "user", "power user"}, splitParams("admin user 'power user'"))
+	assert.Equal(t, []string{"Nick", "Phone"}, splitParams(" Nick  Phone "))
+	assert.Empty(t, splitParams(""))
+}
+
+// loadStaticFixture builds the codegen objects gqlgen would produce for the
+// models of the statictest package.
+func loadStaticFixture(t *testing.T) (codegen.Objects, map[string]map[string]string, set) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join("internal", "statictest", "models.go"), nil, 0)
+	require.NoError(t, err)
+
+	pkg, err := (&types.Config{}).Check("statictest", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	var inputs codegen.Objects
+	rules := make(map[string]map[string]string)
+	markers := make(set)
+
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		st, ok := typeName.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		obj := &codegen.Object{Definition: &gqlast.Definition{Name: name, Kind: gqlast.InputObject}, Type: typeName.Type()}
+		for i := range st.NumFields() {
+			field, tag := st.Field(i), reflect.StructTag(st.Tag(i))
+			jsonName, _, _ := strings.Cut(tag.Get("json"), ",")
+
+			obj.Fields = append(obj.Fields, &codegen.Field{
+				FieldDefinition: &gqlast.FieldDefinition{Name: jsonName},
+				GoFieldName:     field.Name(),
+				TypeReference:   &config.TypeReference{GO: field.Type()},
+			})
+			if rule, ok := tag.Lookup("validate"); ok {
+				if rules[name] == nil {
+					rules[name] = make(map[string]string)
+				}
+				rules[name][jsonName] = rule
+			}
+		}
+
+		if types.NewMethodSet(typeName.Type()).Lookup(typeName.Pkg(), "IsValidatable") != nil {
+			markers.add(name)
+		}
+		inputs = append(inputs, obj)
+	}
+
+	return inputs, rules, markers
+}
@@ -0,0 +1,117 @@
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// structDirectiveName identifies the directive carrying object level rules.
+	structDirectiveName = "validateStruct"
+
+	// oneOfDirectiveName identifies the built-in directive of OneOf input objects.
+	oneOfDirectiveName = "oneOf"
+
+	// oneOfRule is the struct rule enforcing @oneOf, also reported as the rule
+	// of its errors.
+	oneOfRule = "oneOf"
+)
+
+// builtinStructRules lists the struct rules implemented by the runtime.
+var builtinStructRules = set{"required_any": {}, "ordered": {}, "sum": {}}
+
+// structRule is a @validateStruct directive found on an input object. Param
+// references fields by their GraphQL names.
+type structRule struct {
+	Name    string
+	Param   string
+	Message string
+}
+
+// collectStructRules records the @validateStruct rules of an input object and
+// checks them against the known struct rules and the fields of the object.
+// OneOf input objects get the oneOf rule listing all their fields, since
+// gqlparser only checks @oneOf for literals, not for variables.
+func (p *Plugin) collectStructRules(def *ast.Definition, siblings set) ([]ruleError, error) {
+	var problems []ruleError
+
+	if def.Directives.ForName(oneOfDirectiveName) != nil {
+		names := make([]string, 0, len(def.Fields))
+		for _, field := range def.Fields {
+			names = append(names, field.Name)
+		}
+		p.structRules[def.Name] = append(p.structRules[def.Name], structRule{Name: oneOfRule, Param: strings.Join(names, " ")})
+	}
+
+	for _, directive := range def.Directives.ForNames(structDirectiveName) {
+		ruleArg := directive.Arguments.ForName("rule")
+		rule, err := getArgumentValueAsString(ruleArg)
+		if err != nil || strings.TrimSpace(rule) == "" {
+			return nil, fmt.Errorf("@%s on %s requires a rule", structDirectiveName, def.Name)
+		}
+
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		pos := ruleArg.Position
+		if ruleArg.Value != nil && ruleArg.Value.Position != nil {
+			pos = ruleArg.Value.Position
+		}
+		for _, problem := range checkStructRule(name, param, p.structTags, siblings) {
+			problems = append(problems, ruleError{pos: pos, message: fmt.Sprintf("@%s on %s: %s", structDirectiveName, def.Name, problem)})
+		}
+
+		messageArg := directive.Arguments.ForName("message")
+		message, err := getArgumentValueAsString(messageArg)
+		if err == nil {
+			for _, problem := range checkMessage(message) {
+				problems = append(problems, ruleError{pos: messageArg.Value.Position, message: fmt.Sprintf("@%s on %s: %s", structDirectiveName, def.Name, problem)})
+			}
+		}
+
+		p.structRules[def.Name] = append(p.structRules[def.Name], structRule{Name: name, Param: param, Message: message})
+	}
+
+	return problems, nil
+}
+
+// checkStructRule verifies a struct rule name and, for the built-in rules, that
+// its parameter lists fields of the object.
+func checkStructRule(name, param string, rules, siblings set) []string {
+	if !rules.contains(name) {
+		return []string{fmt.Sprintf("unknown struct rule %q", name)}
+	}
+
+	fields := strings.Fields(param)
+	switch name {
+	case "required_any", "ordered":
+		if len(fields) < 2 {
+			return []string{fmt.Sprintf("%s expects at least two fields (got %q)", name, param)}
+		}
+	case "sum":
+		if len(fields) < 2 {
+			return []string{fmt.Sprintf("sum expects a total followed by fields (got %q)", param)}
+		}
+		if _, err := strconv.ParseFloat(fields[0], 64); err != nil {
+			return []string{fmt.Sprintf("sum total %q is not a number", fields[0])}
+		}
+		fields = fields[1:]
+	default:
+		return nil
+	}
+
+	var problems []string
+	for _, field := range fields {
+		if !siblings.contains(field) {
+			problems = append(problems, fmt.Sprintf("%s references unknown field %q", name, field))
+		}
+	}
+	return problems
+}
+
+// structObject lists the struct rules of a single input object.
+type structObject struct {
+	Object string
+	Rules  []structRule
+}
@@ -0,0 +1,67 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// transformDirectiveName identifies the directive normalizing input values
+// before they are validated.
+const transformDirectiveName = "validateTransform"
+
+// builtinTransforms are the transforms implemented by the runtime.
+var builtinTransforms = set{"trim": {}, "lower": {}, "upper": {}, "nfc": {}, "collapse": {}}
+
+// nonStringScalars are the built-in scalars that @validateTransform cannot
+// apply to.
+var nonStringScalars = set{"Int": {}, "Float": {}, "Boolean": {}}
+
+// readTransforms reads the operations of the @validateTransform directive
+// applied to owner, a value of type typ, or nil when there is none. Unknown
+// operations and types without strings are returned as problems.
+func (p *Plugin) readTransforms(schema *ast.Schema, owner string, directives ast.DirectiveList, typ *ast.Type) ([]string, []ruleError, error) {
+	transforms := directives.ForNames(transformDirectiveName)
+	if len(transforms) == 0 {
+		return nil, nil, nil
+	}
+	if len(transforms) > 1 {
+		return nil, nil, fmt.Errorf("@%s may only be applied once per field or argument (%s)", transformDirectiveName, owner)
+	}
+
+	arg := transforms[0].Arguments.ForName("ops")
+	if arg == nil || arg.Value == nil || arg.Value.Kind == ast.NullValue {
+		return nil, nil, fmt.Errorf("@%s on %s requires ops", transformDirectiveName, owner)
+	}
+	values := []*ast.Value{arg.Value}
+	if arg.Value.Kind == ast.ListValue {
+		values = values[:0]
+		for _, child := range arg.Value.Children {
+			values = append(values, child.Value)
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil, fmt.Errorf("@%s on %s requires ops", transformDirectiveName, owner)
+	}
+
+	var problems []ruleError
+	if def := schema.Types[typ.Name()]; def == nil || def.Kind != ast.Scalar || nonStringScalars.contains(def.Name) {
+		problems = append(problems, ruleError{pos: transforms[0].Position, message: fmt.Sprintf(
+			"@%s on %s: only strings can be transformed (got %s)", transformDirectiveName, owner, typ.String())})
+	}
+
+	ops := make([]string, 0, len(values))
+	for _, value := range values {
+		if value.Kind != ast.StringValue && value.Kind != ast.BlockValue {
+			return nil, nil, fmt.Errorf("@%s on %s: ops must be a string or a list of strings (got %s)", transformDirectiveName, owner, value.String())
+		}
+		op := strings.TrimSpace(value.Raw)
+		if !p.transforms.contains(op) {
+			problems = append(problems, ruleError{pos: value.Position, message: fmt.Sprintf(
+				"@%s on %s: unknown transform %q", transformDirectiveName, owner, op)})
+		}
+		ops = append(ops, op)
+	}
+	return ops, problems, nil
+}
@@ -0,0 +1,242 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultAsyncWorkers bounds the concurrent lookups of a single validation
+// unless WithAsyncWorkers says otherwise.
+const defaultAsyncWorkers = 8
+
+// AsyncFunc implements an async validation, such as a uniqueness check against
+// a store. It reports whether value satisfies the tag with param; an error,
+// including the one of a cancelled ctx, fails the whole field instead.
+type AsyncFunc func(ctx context.Context, value any, param string) (bool, error)
+
+// asyncKey identifies a lookup: the same tag, param and value are only looked
+// up once per operation.
+type asyncKey struct {
+	tag   string
+	param string
+	value any
+}
+
+// asyncRun carries the lookups of one validation through the engine. The async
+// validations report the looked up results and record the keys they have none
+// for, passing until the next round looked them up.
+type asyncRun struct {
+	keys    []asyncKey
+	seen    map[asyncKey]struct{}
+	results map[asyncKey]bool
+	err     error
+}
+
+type asyncRunKey struct{}
+
+// asyncValidation adapts fn to the engine. Outside of a Validator, e.g. when the
+// engine is used directly, fn runs synchronously.
+func asyncValidation(tag string, fn AsyncFunc) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		value := fl.Field().Interface()
+		run, _ := ctx.Value(asyncRunKey{}).(*asyncRun)
+		if run == nil {
+			ok, err := fn(ctx, value, fl.Param())
+			return err == nil && ok
+		}
+
+		if value != nil && !reflect.TypeOf(value).Comparable() {
+			if run.err == nil {
+				run.err = fmt.Errorf("%s: values of type %T cannot be looked up", tag, value)
+			}
+			return true
+		}
+
+		key := asyncKey{tag: tag, param: fl.Param(), value: value}
+		if ok, done := run.results[key]; done {
+			return ok
+		}
+		if _, ok := run.seen[key]; !ok {
+			run.seen[key] = struct{}{}
+			run.keys = append(run.keys, key)
+		}
+		return true
+	}
+}
+
+// validateAsync validates root like validate and, when that passes and root
+// uses async validations, runs their lookups concurrently before validating it
+// again with the results. A result may lead the engine to other async
+// validations, e.g. the second alternative of "a|b", which take another round.
+// Invalid values are rejected without lookups.
+func (v *Validator) validateAsync(ctx context.Context, root any) error {
+	if len(v.asyncFuncs) == 0 {
+		return v.validate(ctx, root)
+	}
+
+	run := &asyncRun{seen: make(map[asyncKey]struct{}), results: make(map[asyncKey]bool)}
+	runCtx := context.WithValue(ctx, asyncRunKey{}, run)
+	for {
+		err := v.validate(runCtx, root)
+		if err != nil || run.err != nil || len(run.keys) == 0 {
+			if err == nil {
+				err = run.err
+			}
+			return err
+		}
+
+		results, err := v.lookup(ctx, run.keys)
+		if err != nil {
+			return err
+		}
+		maps.Copy(run.results, results)
+		run.keys = nil
+	}
+}
+
+// lookup runs the lookups on at most asyncWorkers goroutines. Results are
+// shared through the cache of the operation, if any, and the first error
+// cancels the remaining lookups.
+func (v *Validator) lookup(ctx context.Context, keys []asyncKey) (map[asyncKey]bool, error) {
+	cache, ok := ctx.Value(asyncCacheKey{}).(*asyncCache)
+	if !ok {
+		cache = newAsyncCache()
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(v.asyncWorkers)
+
+	results := make([]bool, len(keys))
+	for i, key := range keys {
+		g.Go(func() error {
+			ok, err := cache.do(gctx, key, v.asyncFuncs[key.tag])
+			if err != nil {
+				return fmt.Errorf("%s: %w", key.tag, err)
+			}
+			results[i] = ok
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	out := make(map[asyncKey]bool, len(keys))
+	for i, key := range keys {
+		out[key] = results[i]
+	}
+	return out, nil
+}
+
+// asyncCache deduplicates the lookups of an operation, including concurrent
+// ones from fields resolved in parallel. Failed lookups are not cached.
+type asyncCache struct {
+	mu      sync.Mutex
+	entries map[asyncKey]*asyncEntry
+}
+
+type asyncEntry struct {
+	done chan struct{}
+	ok   bool
+	err  error
+}
+
+type asyncCacheKey struct{}
+
+func newAsyncCache() *asyncCache {
+	return &asyncCache{entries: make(map[asyncKey]*asyncEntry)}
+}
+
+// do returns the result of the lookup of key, calling fn unless the lookup is
+// already done or in progress. A lookup that failed because the context of the
+// field running it ended is retried with ctx, which may still be alive.
+func (c *asyncCache) do(ctx context.Context, key asyncKey, fn AsyncFunc) (bool, error) {
+	for {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		if !ok {
+			entry = &asyncEntry{done: make(chan struct{})}
+			c.entries[key] = entry
+		}
+		c.mu.Unlock()
+
+		if !ok {
+			return c.run(ctx, key, entry, fn)
+		}
+		select {
+		case <-entry.done:
+			if isContextError(entry.err) && ctx.Err() == nil {
+				continue
+			}
+			return entry.ok, entry.err
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// run looks key up for entry. Failed lookups are removed from the cache before
+// the waiters are released, so that a retry starts a new one.
+func (c *asyncCache) run(ctx context.Context, key asyncKey, entry *asyncEntry, fn AsyncFunc) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		entry.err = err
+	} else {
+		entry.ok, entry.err = fn(ctx, key.value, key.param)
+	}
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	close(entry.done)
+	return entry.ok, entry.err
+}
+
+// reset drops the cached lookups.
+func (c *asyncCache) reset() {
+	c.mu.Lock()
+	c.entries = make(map[asyncKey]*asyncEntry)
+	c.mu.Unlock()
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// OperationMiddleware returns a gqlgen operation middleware that shares the
+// results of async validations between the fields of an operation, so that a
+// value is looked up once however often it occurs. Without it, lookups are
+// only deduplicated within a single argument or result.
+//
+// The root fields of a mutation run one after another and may change what the
+// next one looks up, e.g. register an address, so each of them starts with an
+// empty cache; only the fields below it share its lookups.
+func (v *Validator) OperationMiddleware() graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(context.WithValue(ctx, asyncCacheKey{}, newAsyncCache()))
+	}
+}
+
+// resetMutationLookups empties the cache of the operation when fc is a root
+// field of a mutation. gqlgen resolves those, including the fields below them,
+// one at a time, so no lookup is in progress.
+func resetMutationLookups(ctx context.Context, fc *graphql.FieldContext) {
+	cache, ok := ctx.Value(asyncCacheKey{}).(*asyncCache)
+	if !ok || fc.Parent != nil {
+		return
+	}
+	if oc := OperationContext(ctx); oc != nil && oc.Operation != nil && oc.Operation.Operation == ast.Mutation {
+		cache.reset()
+	}
+}
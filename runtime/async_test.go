@@ -0,0 +1,260 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type inviteInput struct {
+	Owner  string   `json:"owner" validate:"required,email,unregistered"`
+	Emails []string `json:"emails" validate:"max=4,dive,unregistered"`
+}
+
+func (inviteInput) IsValidatable() {}
+
+// store counts the lookups of registered addresses and the most that ran at
+// once.
+type store struct {
+	registered map[string]bool
+	delay      time.Duration
+	err        error
+
+	mu      sync.Mutex
+	calls   map[string]int
+	running int
+	peak    int
+}
+
+func (s *store) unregistered(ctx context.Context, value any, _ string) (bool, error) {
+	s.mu.Lock()
+	s.calls[value.(string)]++
+	s.running++
+	s.peak = max(s.peak, s.running)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.running--
+		s.mu.Unlock()
+	}()
+
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+	if s.err != nil {
+		return false, s.err
+	}
+	return !s.registered[value.(string)], nil
+}
+
+func TestAsyncValidation(t *testing.T) {
+	newStore := func() *store {
+		return &store{
+			registered: map[string]bool{"taken@example.com": true},
+			delay:      10 * time.Millisecond,
+			calls:      make(map[string]int),
+		}
+	}
+
+	t.Run("looks up every value once", func(t *testing.T) {
+		s := newStore()
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered), WithAsyncWorkers(2))
+		require.NoError(t, err)
+
+		err = v.Validate(context.Background(), inviteInput{
+			Owner:  "a@example.com",
+			Emails: []string{"b@example.com", "taken@example.com", "a@example.com", "c@example.com"},
+		})
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "emails[1]", errs[0].Path.String())
+		assert.Equal(t, "unregistered", errs[0].Extensions["rule"])
+
+		assert.Equal(t, map[string]int{"a@example.com": 1, "b@example.com": 1, "taken@example.com": 1, "c@example.com": 1}, s.calls)
+		assert.Equal(t, 2, s.peak)
+	})
+
+	t.Run("skips lookups of invalid values", func(t *testing.T) {
+		s := newStore()
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered))
+		require.NoError(t, err)
+
+		err = v.Validate(context.Background(), inviteInput{Owner: "not an email", Emails: []string{"b@example.com"}})
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		assert.Equal(t, "email", errs[0].Extensions["rule"])
+		assert.Empty(t, s.calls)
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		s := newStore()
+		s.err = errors.New("connection refused")
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered))
+		require.NoError(t, err)
+
+		err = v.Validate(context.Background(), inviteInput{Owner: "a@example.com"})
+		assert.EqualError(t, err, "unregistered: connection refused")
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		s := newStore()
+		s.delay = time.Minute
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = v.Validate(ctx, inviteInput{Owner: "a@example.com", Emails: []string{"b@example.com"}})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("shares lookups within an operation", func(t *testing.T) {
+		s := newStore()
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered))
+		require.NoError(t, err)
+		RegisterArguments("Mutation", "invite", Argument{Name: "email", Rule: "unregistered"})
+		t.Cleanup(func() { arguments.Delete("Mutation.invite") })
+
+		var failed atomic.Int32
+		var wg sync.WaitGroup
+		v.OperationMiddleware()(context.Background(), func(ctx context.Context) graphql.ResponseHandler {
+			for range 3 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					fc := &graphql.FieldContext{
+						Object: "Mutation",
+						Field:  graphql.CollectedField{Field: &ast.Field{Name: "invite", Alias: "invite"}},
+						Args:   map[string]any{"email": "taken@example.com", "input": &inviteInput{Owner: "taken@example.com"}},
+					}
+					_, err := v.Middleware()(graphql.WithFieldContext(ctx, fc), func(context.Context) (any, error) {
+						return nil, nil
+					})
+					if err != nil {
+						failed.Add(1)
+					}
+				}()
+			}
+			wg.Wait()
+			return nil
+		})
+
+		assert.Equal(t, int32(3), failed.Load())
+		assert.Equal(t, map[string]int{"taken@example.com": 1}, s.calls)
+	})
+
+	t.Run("looks up alternatives in another round", func(t *testing.T) {
+		s := newStore()
+		var claimed []any
+		v, err := New(
+			WithAsyncValidation("unregistered", s.unregistered),
+			WithAsyncValidation("unclaimed", func(_ context.Context, value any, _ string) (bool, error) {
+				claimed = append(claimed, value)
+				return true, nil
+			}),
+		)
+		require.NoError(t, err)
+
+		type handleInput struct {
+			Handle string `json:"handle" validate:"unregistered|unclaimed"`
+		}
+		assert.NoError(t, v.Validate(context.Background(), handleInput{Handle: "taken@example.com"}))
+		assert.Equal(t, []any{"taken@example.com"}, claimed)
+	})
+
+	t.Run("does not share lookups between mutation fields", func(t *testing.T) {
+		s := newStore()
+		v, err := New(WithAsyncValidation("unregistered", s.unregistered))
+		require.NoError(t, err)
+		RegisterArguments("Mutation", "register", Argument{Name: "email", Rule: "unregistered"})
+		t.Cleanup(func() { arguments.Delete("Mutation.register") })
+
+		resolve := func(operation ast.Operation) []error {
+			var errs []error
+			ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+				Operation: &ast.OperationDefinition{Operation: operation},
+			})
+			v.OperationMiddleware()(ctx, func(ctx context.Context) graphql.ResponseHandler {
+				for range 2 {
+					fc := fieldContext("Mutation", "register", map[string]any{"email": "a@example.com"})
+					_, err := resolveField(ctx, v, fc, func(context.Context) (any, error) {
+						s.mu.Lock()
+						s.registered["a@example.com"] = true
+						s.mu.Unlock()
+						return nil, nil
+					})
+					errs = append(errs, err)
+				}
+				return nil
+			})
+			return errs
+		}
+
+		errs := resolve(ast.Mutation)
+		assert.NoError(t, errs[0])
+		assert.Error(t, errs[1], "the second field sees the address registered by the first")
+		assert.Equal(t, 2, s.calls["a@example.com"])
+
+		delete(s.registered, "a@example.com")
+		s.calls = make(map[string]int)
+		errs = resolve(ast.Query)
+		assert.NoError(t, errs[0])
+		assert.NoError(t, errs[1])
+		assert.Equal(t, 1, s.calls["a@example.com"])
+	})
+
+	t.Run("retries lookups cancelled for another field", func(t *testing.T) {
+		cache := newAsyncCache()
+		key := asyncKey{tag: "unregistered", value: "a@example.com"}
+
+		var calls atomic.Int32
+		started := make(chan struct{})
+		fn := func(ctx context.Context, _ any, _ string) (bool, error) {
+			if calls.Add(1) == 1 {
+				close(started)
+				<-ctx.Done()
+				return false, ctx.Err()
+			}
+			return true, nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		first := make(chan error, 1)
+		go func() {
+			_, err := cache.do(ctx, key, fn)
+			first <- err
+		}()
+		<-started
+
+		second := make(chan bool, 1)
+		go func() {
+			ok, err := cache.do(context.Background(), key, fn)
+			assert.NoError(t, err)
+			second <- ok
+		}()
+		cancel()
+
+		assert.ErrorIs(t, <-first, context.Canceled)
+		assert.True(t, <-second)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("options", func(t *testing.T) {
+		_, err := New(WithAsyncValidation("unregistered", nil))
+		assert.EqualError(t, err, "gqlgen-validate: WithAsyncValidation requires a function")
+		_, err = New(WithAsyncWorkers(0))
+		assert.EqualError(t, err, "gqlgen-validate: WithAsyncWorkers requires at least one worker (got 0)")
+	})
+}
@@ -0,0 +1,26 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// OperationContext returns the gqlgen operation being executed, e.g. to read
+// its headers or variables from a validation registered with
+// WithValidationCtx. It returns nil outside of a GraphQL request, such as for
+// Validator.Validate called from a REST handler.
+func OperationContext(ctx context.Context) *graphql.OperationContext {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	return graphql.GetOperationContext(ctx)
+}
+
+// FieldContext returns the gqlgen context of the field whose arguments or
+// result are being validated, e.g. to tell which resolver a validation
+// registered with WithValidationCtx runs for. It returns nil outside of a
+// resolver.
+func FieldContext(ctx context.Context) *graphql.FieldContext {
+	return graphql.GetFieldContext(ctx)
+}
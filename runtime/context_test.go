@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type grantInput struct {
+	Role string `json:"role" validate:"grantable"`
+}
+
+func (grantInput) IsValidatable() {}
+
+func TestValidationCtx(t *testing.T) {
+	var fields []string
+	v, err := New(WithValidationCtx("grantable", func(ctx context.Context, fl validator.FieldLevel) bool {
+		if fc := FieldContext(ctx); fc != nil {
+			fields = append(fields, fc.Object+"."+fc.Field.Name)
+		}
+		oc := OperationContext(ctx)
+		if oc == nil {
+			return false
+		}
+		return oc.Headers.Get("X-Role") == "ADMIN" || fl.Field().String() == "MEMBER"
+	}))
+	require.NoError(t, err)
+
+	resolve := func(role, grant string) error {
+		ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Headers: http.Header{"X-Role": []string{role}},
+		})
+		fc := fieldContext("Mutation", "grant", map[string]any{"input": &grantInput{Role: grant}})
+		_, err := resolveField(ctx, v, fc, func(context.Context) (any, error) { return nil, nil })
+		return err
+	}
+
+	require.NoError(t, resolve("ADMIN", "ADMIN"))
+	require.NoError(t, resolve("MEMBER", "MEMBER"))
+
+	err = resolve("MEMBER", "ADMIN")
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, "grant.role", gqlErr.Path.String())
+	assert.Equal(t, "grantable", gqlErr.Extensions["rule"])
+	assert.Equal(t, []string{"Mutation.grant", "Mutation.grant", "Mutation.grant"}, fields)
+
+	t.Run("outside of a request", func(t *testing.T) {
+		assert.Nil(t, OperationContext(context.Background()))
+		assert.Nil(t, FieldContext(context.Background()))
+		assert.Error(t, v.Validate(context.Background(), grantInput{Role: "MEMBER"}))
+	})
+}
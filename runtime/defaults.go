@@ -0,0 +1,153 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// defaultTag is the struct tag naming the @validateDefault of an input field,
+// e.g. default:"now".
+const defaultTag = "default"
+
+// DefaultFunc computes the value of a @validateDefault. It runs for every
+// omitted or null field, so values such as slices must not be shared between
+// calls. A nil result stands for the empty value of the field: an empty list or
+// map, or a pointer to the zero value.
+type DefaultFunc func(ctx context.Context) any
+
+// builtinDefaults implements the defaults known to the plugin.
+var builtinDefaults = map[string]DefaultFunc{
+	"now":   func(context.Context) any { return time.Now() },
+	"empty": func(context.Context) any { return nil },
+}
+
+// defaultArguments fills the nil fields carrying a default tag in the input
+// models of fc.Args, so that rules such as required see the default instead of
+// the missing value, and so does the resolver.
+func (v *Validator) defaultArguments(ctx context.Context, fc *graphql.FieldContext) error {
+	for name, value := range fc.Args {
+		rv := reflect.ValueOf(value)
+		if !rv.IsValid() || !v.hasTag(rv.Type(), defaultTag) {
+			continue
+		}
+
+		// Values stored in the map are not addressable; fill a copy.
+		out := reflect.New(rv.Type()).Elem()
+		out.Set(rv)
+		if err := v.applyDefaults(ctx, out); err != nil {
+			return fmt.Errorf("argument %s: %w", name, err)
+		}
+		fc.Args[name] = out.Interface()
+	}
+	return nil
+}
+
+// applyDefaults fills the nil fields carrying a default tag in the input models
+// of the addressable value rv, including the models of the defaults themselves.
+func (v *Validator) applyDefaults(ctx context.Context, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return v.applyDefaults(ctx, rv.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.applyDefaults(ctx, rv.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			if err := v.applyDefaults(ctx, elem); err != nil {
+				return err
+			}
+			rv.SetMapIndex(iter.Key(), elem)
+		}
+	case reflect.Struct:
+		typ := rv.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+
+			fv := rv.Field(i)
+			if name := f.Tag.Get(defaultTag); name != "" && isNil(fv) {
+				value, err := v.defaultValue(ctx, name, f.Type)
+				if err != nil {
+					return fmt.Errorf("%s: %w", f.Name, err)
+				}
+				fv.Set(value)
+			}
+			if !v.hasTag(f.Type, defaultTag) {
+				continue
+			}
+			if err := v.applyDefaults(ctx, fv); err != nil {
+				return fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultValue computes the named default for a field of type typ. Values are
+// assigned as is or converted between types of the same kind, e.g. a string to
+// a generated enum, and stored behind a new pointer for pointer fields.
+func (v *Validator) defaultValue(ctx context.Context, name string, typ reflect.Type) (reflect.Value, error) {
+	fn, ok := v.defaults[name]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("undefined default %q", name)
+	}
+
+	value := fn(ctx)
+	if value == nil {
+		switch typ.Kind() {
+		case reflect.Pointer:
+			return reflect.New(typ.Elem()), nil
+		case reflect.Slice:
+			return reflect.MakeSlice(typ, 0, 0), nil
+		case reflect.Map:
+			return reflect.MakeMap(typ), nil
+		default:
+			return reflect.Zero(typ), nil
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	target := typ
+	if typ.Kind() == reflect.Pointer && !rv.Type().AssignableTo(typ) {
+		target = typ.Elem()
+	}
+	switch {
+	case rv.Type().AssignableTo(target):
+	case rv.Kind() == target.Kind() && rv.Type().ConvertibleTo(target):
+		rv = rv.Convert(target)
+	default:
+		return reflect.Value{}, fmt.Errorf("default %q of type %T cannot be assigned to %s", name, value, typ)
+	}
+
+	if target == typ {
+		return rv, nil
+	}
+	ptr := reflect.New(target)
+	ptr.Elem().Set(rv)
+	return ptr, nil
+}
+
+// isNil reports whether rv holds a nil pointer, list, map or interface.
+func isNil(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type localeCode string
+
+type defaultedSettings struct {
+	Locale *localeCode `json:"locale" default:"locale"`
+}
+
+type defaultedInput struct {
+	CreatedAt *time.Time           `json:"createdAt" default:"now" validate:"required"`
+	Tags      []string             `json:"tags" default:"empty" validate:"required"`
+	Labels    map[string]string    `json:"labels" default:"empty"`
+	Settings  *defaultedSettings   `json:"settings" default:"empty"`
+	Nested    []*defaultedSettings `json:"nested"`
+	Bio       *string              `json:"bio"`
+}
+
+func (defaultedInput) IsValidatable() {}
+
+func TestDefaults(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	v, err := New(
+		WithDefault("now", func(context.Context) any { return now }),
+		WithDefault("locale", func(context.Context) any { return "en" }),
+	)
+	require.NoError(t, err)
+
+	resolve := func(v *Validator, input any) (any, error) {
+		fc := &graphql.FieldContext{Args: map[string]any{"input": input}}
+		var seen any
+		_, err := resolveField(context.Background(), v, fc, func(ctx context.Context) (any, error) {
+			seen = graphql.GetFieldContext(ctx).Args["input"]
+			return nil, nil
+		})
+		return seen, err
+	}
+
+	t.Run("fills nil fields", func(t *testing.T) {
+		de := localeCode("de")
+		res, err := resolve(v, defaultedInput{Nested: []*defaultedSettings{{}, {Locale: &de}}})
+		require.NoError(t, err)
+
+		got := res.(defaultedInput)
+		assert.Equal(t, now, *got.CreatedAt)
+		assert.Equal(t, []string{}, got.Tags)
+		assert.Equal(t, map[string]string{}, got.Labels)
+		require.NotNil(t, got.Settings)
+		assert.Equal(t, localeCode("en"), *got.Settings.Locale)
+		assert.Equal(t, localeCode("en"), *got.Nested[0].Locale)
+		assert.Equal(t, localeCode("de"), *got.Nested[1].Locale)
+		assert.Nil(t, got.Bio)
+	})
+
+	t.Run("keeps set fields", func(t *testing.T) {
+		createdAt := now.Add(-time.Hour)
+		res, err := resolve(v, &defaultedInput{CreatedAt: &createdAt, Tags: []string{"a"}})
+		require.NoError(t, err)
+
+		got := res.(*defaultedInput)
+		assert.Equal(t, createdAt, *got.CreatedAt)
+		assert.Equal(t, []string{"a"}, got.Tags)
+	})
+
+	t.Run("before validation", func(t *testing.T) {
+		v, err := New(WithDefault("locale", func(context.Context) any { return "en" }))
+		require.NoError(t, err)
+
+		res, err := resolve(v, defaultedInput{})
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), *res.(defaultedInput).CreatedAt, time.Minute)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		v, err := New()
+		require.NoError(t, err)
+		_, err = resolve(v, defaultedInput{})
+		assert.EqualError(t, err, `input: gqlgen-validate: argument input: Settings: Locale: undefined default "locale"`)
+
+		v, err = New(WithDefault("locale", func(context.Context) any { return 42 }))
+		require.NoError(t, err)
+		_, err = resolve(v, defaultedInput{})
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, `gqlgen-validate: argument input: Settings: Locale: default "locale" of type int cannot be assigned to *runtime.localeCode`, gqlErr.Message)
+	})
+}
@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorFormatter builds the GraphQL error reported for a failed validation.
+// root is the validated value: the input model, or the argument value for
+// scalar arguments. path is the resolved GraphQL path of the failing field and
+// message the resolved custom, translated or default message. Returning nil
+// falls back to DefaultErrorFormatter.
+type ErrorFormatter interface {
+	FormatError(ctx context.Context, root any, fe validator.FieldError, path ast.Path, message string) *gqlerror.Error
+}
+
+// ErrorFormatterFunc adapts a function to the ErrorFormatter interface.
+type ErrorFormatterFunc func(ctx context.Context, root any, fe validator.FieldError, path ast.Path, message string) *gqlerror.Error
+
+// FormatError implements ErrorFormatter.
+func (f ErrorFormatterFunc) FormatError(ctx context.Context, root any, fe validator.FieldError, path ast.Path, message string) *gqlerror.Error {
+	return f(ctx, root, fe, path, message)
+}
+
+// DefaultErrorFormatter reports the message with the extensions code
+// (BAD_USER_INPUT), field, rule, param and violation, the failed rule as listed
+// in a ValidationError. Custom formatters can call it and adjust the result.
+var DefaultErrorFormatter ErrorFormatter = ErrorFormatterFunc(formatError)
+
+func formatError(_ context.Context, _ any, fe validator.FieldError, path ast.Path, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: message,
+		Path:    path,
+		Extensions: map[string]any{
+			"code":      "BAD_USER_INPUT",
+			"field":     fe.Field(),
+			"rule":      fe.Tag(),
+			"param":     fe.Param(),
+			"violation": newViolation(fe, path, message),
+		},
+	}
+}
+
+// Violation is a single failed rule with its resolved GraphQL path and message.
+type Violation struct {
+	Path       ast.Path             `json:"path"`
+	Rule       string               `json:"rule"`
+	Param      string               `json:"param"`
+	Message    string               `json:"message"`
+	FieldError validator.FieldError `json:"-"`
+}
+
+// ValidationError reports the failed rules of a validated value. Every GraphQL
+// error returned by the runtime wraps the ValidationError of its value, so it
+// can be retrieved with errors.As from the errors of Middleware and Validate.
+type ValidationError struct {
+	// Path is the GraphQL path the violation paths start from: the resolver
+	// field for arguments and results, the path in ctx for Validate.
+	Path       ast.Path
+	Violations []Violation
+}
+
+func newValidationError(path ast.Path, failures []failure) *ValidationError {
+	violations := make([]Violation, 0, len(failures))
+	for _, f := range failures {
+		violations = append(violations, newViolation(f.fe, f.path, f.message))
+	}
+	return &ValidationError{Path: path, Violations: violations}
+}
+
+func newViolation(fe validator.FieldError, path ast.Path, message string) Violation {
+	return Violation{
+		Path:       path,
+		Rule:       fe.Tag(),
+		Param:      fe.Param(),
+		Message:    message,
+		FieldError: fe,
+	}
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("validation failed")
+	for i, violation := range e.Violations {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		if len(violation.Path) > 0 {
+			b.WriteString(violation.Path.String())
+			b.WriteString(": ")
+		}
+		b.WriteString(violation.Message)
+	}
+	return b.String()
+}
+
+// newAggregatedError reports a ValidationError as one GraphQL error on its path
+// with the extensions code (BAD_USER_INPUT) and violations.
+func newAggregatedError(ve *ValidationError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Err:     ve,
+		Message: "validation failed",
+		Path:    ve.Path,
+		Extensions: map[string]any{
+			"code":       "BAD_USER_INPUT",
+			"violations": ve.Violations,
+		},
+	}
+}
@@ -0,0 +1,234 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestWithErrorFormatter(t *testing.T) {
+	type call struct {
+		root    any
+		tag     string
+		path    string
+		message string
+	}
+	var calls []call
+
+	v, err := New(WithErrorFormatter(ErrorFormatterFunc(func(ctx context.Context, root any, fe validator.FieldError, path ast.Path, message string) *gqlerror.Error {
+		calls = append(calls, call{root: root, tag: fe.Tag(), path: path.String(), message: message})
+		if fe.Tag() == "uuid4" {
+			return nil
+		}
+
+		gqlErr := DefaultErrorFormatter.FormatError(ctx, root, fe, path, message)
+		gqlErr.Extensions["code"] = "VALIDATION_FAILED"
+		gqlErr.Extensions["docs"] = "https://example.com/rules/" + fe.Tag()
+		delete(gqlErr.Extensions, "param")
+		return gqlErr
+	})))
+	require.NoError(t, err)
+
+	t.Run("input", func(t *testing.T) {
+		calls = nil
+		input := &simpleInput{}
+		ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+
+		err := v.Validate(ctx, input)
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		assert.Equal(t, map[string]any{
+			"code":      "VALIDATION_FAILED",
+			"docs":      "https://example.com/rules/required",
+			"field":     "name",
+			"rule":      "required",
+			"violation": ve.Violations[0],
+		}, errs[0].Extensions)
+
+		require.Len(t, calls, 1)
+		assert.Same(t, input, calls[0].root)
+		assert.Equal(t, "input.name", calls[0].path)
+		assert.Equal(t, errs[0].Message, calls[0].message)
+	})
+
+	t.Run("scalar argument", func(t *testing.T) {
+		RegisterArguments("Query", "user", Argument{Name: "id", Rule: "uuid4", Message: "id must be a UUID"})
+		t.Cleanup(func() { arguments.Delete("Query.user") })
+
+		calls = nil
+		fc := &graphql.FieldContext{
+			Object: "Query",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "user", Alias: "user"}},
+			Args:   map[string]any{"id": "nope"},
+		}
+		_, err := v.Middleware()(graphql.WithFieldContext(context.Background(), fc), func(ctx context.Context) (any, error) {
+			return "ok", nil
+		})
+
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "BAD_USER_INPUT", gqlErr.Extensions["code"], "nil falls back to the default formatter")
+		assert.Equal(t, "uuid4", gqlErr.Extensions["rule"])
+
+		require.Len(t, calls, 1)
+		assert.Equal(t, call{root: "nope", tag: "uuid4", path: "user.id", message: "id must be a UUID"}, calls[0])
+	})
+
+	t.Run("requires a formatter", func(t *testing.T) {
+		_, err := New(WithErrorFormatter(nil))
+		assert.EqualError(t, err, "gqlgen-validate: WithErrorFormatter requires a formatter")
+	})
+}
+
+func TestWithAggregatedErrors(t *testing.T) {
+	RegisterArguments("Mutation", "register",
+		Argument{Name: "code", Rule: "required,len=6", Message: "code must have {param} digits"},
+	)
+	t.Cleanup(func() { arguments.Delete("Mutation.register") })
+
+	fc := &graphql.FieldContext{
+		Object: "Mutation",
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: "register", Alias: "register"}},
+		Args: map[string]any{
+			"code":  "123",
+			"input": &listRoot{Items: []nestedInner{{Message: "a"}, {Message: "b"}}},
+		},
+	}
+	ctx := graphql.WithFieldContext(context.Background(), fc)
+	next := func(ctx context.Context) (any, error) { return "ok", nil }
+
+	t.Run("one error per argument", func(t *testing.T) {
+		v, err := New(WithAggregatedErrors(), WithAllErrors())
+		require.NoError(t, err)
+
+		_, err = v.Middleware()(ctx, next)
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 2)
+
+		assert.Equal(t, "validation failed", errs[0].Message)
+		assert.Equal(t, "register", errs[0].Path.String())
+		assert.Equal(t, "BAD_USER_INPUT", errs[0].Extensions["code"])
+		assert.Equal(t, []Violation{
+			{Path: ast.Path{ast.PathName("register"), ast.PathName("code")}, Rule: "len", Param: "6", Message: "code must have 6 digits"},
+		}, withoutFieldErrors(errs[0].Extensions["violations"]))
+
+		assert.Equal(t, "register", errs[1].Path.String())
+		violations := withoutFieldErrors(errs[1].Extensions["violations"])
+		require.Len(t, violations, 2)
+		assert.Equal(t, "register.items[0].message", violations[0].Path.String())
+		assert.Equal(t, Violation{Path: violations[1].Path, Rule: "min", Param: "2", Message: "message too short"}, violations[1])
+	})
+
+	t.Run("stops at the first argument", func(t *testing.T) {
+		v, err := New(WithAggregatedErrors())
+		require.NoError(t, err)
+
+		_, err = v.Middleware()(ctx, next)
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "register", gqlErr.Path.String())
+		assert.Equal(t, "register.code", withoutFieldErrors(gqlErr.Extensions["violations"])[0].Path.String())
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		v, err := New(WithAggregatedErrors())
+		require.NoError(t, err)
+
+		ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+		err = v.Validate(ctx, &simpleInput{})
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "input", errs[0].Path.String())
+		assert.Equal(t, []Violation{
+			{Path: ast.Path{ast.PathName("input"), ast.PathName("name")}, Rule: "required", Message: "name must not be empty"},
+		}, withoutFieldErrors(errs[0].Extensions["violations"]))
+	})
+}
+
+// withoutFieldErrors drops the go-playground errors from violations so they can
+// be compared with literals.
+func withoutFieldErrors(violations any) []Violation {
+	out := slices.Clone(violations.([]Violation))
+	for i := range out {
+		out[i].FieldError = nil
+	}
+	return out
+}
+
+func TestValidationError(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+
+	t.Run("validate", func(t *testing.T) {
+		err := Validate(ctx, &listRoot{Items: []nestedInner{{Message: "a"}, {Message: "ok"}, {Message: "b"}}})
+
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		assert.Equal(t, "input", ve.Path.String())
+		require.Len(t, ve.Violations, 2)
+		assert.Equal(t, "input.items[0].message", ve.Violations[0].Path.String())
+		assert.Equal(t, "input.items[2].message", ve.Violations[1].Path.String())
+		assert.Equal(t, "min", ve.Violations[1].Rule)
+		assert.Equal(t, "listRoot.Items[2].Message", ve.Violations[1].FieldError.StructNamespace())
+		assert.Equal(t, "validation failed: input.items[0].message: message too short; input.items[2].message: message too short", ve.Error())
+
+		var gqlErrs gqlerror.List
+		require.True(t, errors.As(err, &gqlErrs))
+		assert.Len(t, gqlErrs, 2)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, Validate(ctx, &simpleInput{Name: "Ada"}))
+	})
+
+	t.Run("middleware", func(t *testing.T) {
+		fc := &graphql.FieldContext{Args: map[string]any{"input": &simpleInput{}}}
+		_, err := Middleware()(graphql.WithFieldContext(ctx, fc), func(ctx context.Context) (any, error) {
+			return "ok", nil
+		})
+
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		require.Len(t, ve.Violations, 1)
+		assert.Equal(t, "required", ve.Violations[0].Rule)
+		assert.Equal(t, "name must not be empty", ve.Violations[0].Message)
+
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, ve.Violations[0], gqlErr.Extensions["violation"])
+	})
+
+	t.Run("aggregated", func(t *testing.T) {
+		v, err := New(WithAggregatedErrors())
+		require.NoError(t, err)
+
+		var ve *ValidationError
+		require.True(t, errors.As(v.Validate(ctx, &simpleInput{}), &ve))
+		assert.Equal(t, "validation failed: input.name: name must not be empty", ve.Error())
+	})
+
+	t.Run("keeps formatter errors", func(t *testing.T) {
+		sentinel := errors.New("sentinel")
+		v, err := New(WithErrorFormatter(ErrorFormatterFunc(func(_ context.Context, _ any, _ validator.FieldError, path ast.Path, message string) *gqlerror.Error {
+			return &gqlerror.Error{Err: sentinel, Message: message, Path: path}
+		})))
+		require.NoError(t, err)
+
+		err = v.Validate(ctx, &simpleInput{})
+		assert.ErrorIs(t, err, sentinel)
+		var ve *ValidationError
+		assert.False(t, errors.As(err, &ve))
+	})
+}
@@ -0,0 +1,187 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Option configures a Validator.
+type Option func(*Validator) error
+
+// WithValidation registers a custom validation function under tag. Schemas using
+// the tag must also pass it to the plugin with gen.WithCustomTags. Overriding a
+// built-in tag that generated validation code implements disables that code.
+func WithValidation(tag string, fn validator.Func, callValidationEvenIfNull ...bool) Option {
+	return func(v *Validator) error {
+		if _, ok := staticTags[tag]; ok {
+			v.dynamicOnly = true
+		}
+		return v.validator.RegisterValidation(tag, fn, callValidationEvenIfNull...)
+	}
+}
+
+// WithValidationCtx registers a custom validation function under tag that
+// receives the context of the request, e.g. to check a value against the
+// permissions of the caller. OperationContext and FieldContext retrieve the
+// gqlgen contexts from it. Schemas using the tag must also pass it to the
+// plugin with gen.WithCustomTags.
+func WithValidationCtx(tag string, fn validator.FuncCtx, callValidationEvenIfNull ...bool) Option {
+	return func(v *Validator) error {
+		if _, ok := staticTags[tag]; ok {
+			v.dynamicOnly = true
+		}
+		return v.validator.RegisterValidationCtx(tag, fn, callValidationEvenIfNull...)
+	}
+}
+
+// WithAsyncValidation registers a validation under tag that may call out to a
+// store, e.g. to check that an email address is not registered yet. Its
+// lookups run after the other rules passed, concurrently across fields and
+// list elements, and each distinct value is looked up once per validation or,
+// with OperationMiddleware, per operation. Schemas using the tag must also pass
+// it to the plugin with gen.WithCustomTags.
+func WithAsyncValidation(tag string, fn AsyncFunc) Option {
+	return func(v *Validator) error {
+		if fn == nil {
+			return errors.New("WithAsyncValidation requires a function")
+		}
+		if _, ok := staticTags[tag]; ok {
+			v.dynamicOnly = true
+		}
+		v.asyncFuncs[tag] = fn
+		return v.validator.RegisterValidationCtx(tag, asyncValidation(tag, fn))
+	}
+}
+
+// WithAsyncWorkers bounds the number of concurrent lookups of async
+// validations per argument or result; the default is 8.
+func WithAsyncWorkers(n int) Option {
+	return func(v *Validator) error {
+		if n < 1 {
+			return fmt.Errorf("WithAsyncWorkers requires at least one worker (got %d)", n)
+		}
+		v.asyncWorkers = n
+		return nil
+	}
+}
+
+// WithStructLevel registers a struct level validation function for the given
+// types, e.g. model.RegisterUserInput{}. It replaces the @validateStruct rules
+// of those types. Only go-playground runs struct level validations, so
+// registering one disables generated validation code.
+func WithStructLevel(fn validator.StructLevelFunc, types ...any) Option {
+	return func(v *Validator) error {
+		v.validator.RegisterStructValidation(fn, types...)
+		v.dynamicOnly = true
+		return nil
+	}
+}
+
+// WithStructRule registers a struct rule for @validateStruct under name. Schemas
+// using it must also pass it to the plugin with gen.WithStructRules.
+func WithStructRule(name string, fn StructFunc) Option {
+	return func(v *Validator) error {
+		if fn == nil {
+			return errors.New("WithStructRule requires a function")
+		}
+		v.structFuncs[name] = fn
+		return nil
+	}
+}
+
+// WithTransform registers a custom @validateTransform operation under name, or
+// replaces a built-in one. Schemas using it must also pass it to the plugin
+// with gen.WithCustomTransforms.
+func WithTransform(name string, fn TransformFunc) Option {
+	return func(v *Validator) error {
+		if fn == nil {
+			return errors.New("WithTransform requires a function")
+		}
+		v.transforms[name] = fn
+		return nil
+	}
+}
+
+// WithDefault registers a @validateDefault under name, or replaces a built-in
+// one, e.g. WithDefault("locale", func(context.Context) any { return "en" })
+// for a configured constant. Schemas using it must also pass it to the plugin
+// with gen.WithCustomDefaults.
+func WithDefault(name string, fn DefaultFunc) Option {
+	return func(v *Validator) error {
+		if fn == nil {
+			return errors.New("WithDefault requires a function")
+		}
+		v.defaults[name] = fn
+		return nil
+	}
+}
+
+// WithAlias registers alias as a shorthand for tags, e.g.
+// WithAlias("password", "min=12,max=128"). Schemas using the alias must also pass
+// it to the plugin with gen.WithCustomTags.
+func WithAlias(alias, tags string) Option {
+	return func(v *Validator) error {
+		v.validator.RegisterAlias(alias, tags)
+		return nil
+	}
+}
+
+// WithAllErrors makes the middleware validate every argument instead of stopping
+// at the first failing one. All errors are returned as a single gqlerror.List,
+// ordered by argument position and, within an argument, by field.
+func WithAllErrors() Option {
+	return func(v *Validator) error {
+		v.allErrors = true
+		return nil
+	}
+}
+
+// WithErrorFormatter replaces DefaultErrorFormatter, e.g. to use custom error
+// codes, hide rule parameters or link to documentation.
+func WithErrorFormatter(f ErrorFormatter) Option {
+	return func(v *Validator) error {
+		if f == nil {
+			return errors.New("WithErrorFormatter requires a formatter")
+		}
+		v.formatter = f
+		return nil
+	}
+}
+
+// WithAggregatedErrors reports a single error per argument instead of one per
+// failing field. It is pathed at the resolver field and lists every failed rule
+// in extensions.violations as {path, rule, param, message}. The ErrorFormatter
+// is not used in this mode.
+func WithAggregatedErrors() Option {
+	return func(v *Validator) error {
+		v.aggregate = true
+		return nil
+	}
+}
+
+// WithResultValidation makes the middleware validate the values returned by
+// resolvers of output fields carrying @validate, handling violations as mode
+// selects. Results are not validated by default.
+func WithResultValidation(mode ResultMode) Option {
+	return func(v *Validator) error {
+		if mode < ResultError || mode > ResultLog {
+			return fmt.Errorf("unknown result mode %d", mode)
+		}
+		v.resultMode = mode
+		return nil
+	}
+}
+
+// WithResultLogger replaces the slog based logger used by the ResultNull and
+// ResultLog modes.
+func WithResultLogger(fn ResultLogger) Option {
+	return func(v *Validator) error {
+		if fn == nil {
+			return errors.New("WithResultLogger requires a logger")
+		}
+		v.resultLogger = fn
+		return nil
+	}
+}
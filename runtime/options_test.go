@@ -0,0 +1,155 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type accountInput struct {
+	Iban     string `json:"iban" validate:"iban"`
+	Password string `json:"password" validate:"password"`
+	From     int    `json:"from"`
+	To       int    `json:"to"`
+}
+
+func (accountInput) IsValidatable() {}
+
+func TestOptions(t *testing.T) {
+	v, err := New(
+		WithValidation("iban", func(fl validator.FieldLevel) bool {
+			return strings.HasPrefix(fl.Field().String(), "DE")
+		}),
+		WithAlias("password", "min=12,max=128"),
+		WithStructLevel(func(sl validator.StructLevel) {
+			in := sl.Current().Interface().(accountInput)
+			if in.From > in.To {
+				sl.ReportError(in.From, "from", "From", "ltefield", "to")
+			}
+		}, accountInput{}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    accountInput
+		wantErr  string
+		wantPath string
+		wantRule string
+	}{
+		{
+			name:  "valid",
+			value: accountInput{Iban: "DE89370400440532013000", Password: "correct horse battery"},
+		},
+		{
+			name:     "custom validation",
+			value:    accountInput{Iban: "FR1420041010050500013M02606", Password: "correct horse battery"},
+			wantErr:  "iban failed on the 'iban' rule",
+			wantPath: "input.iban",
+			wantRule: "iban",
+		},
+		{
+			name:     "alias",
+			value:    accountInput{Iban: "DE89370400440532013000", Password: "short"},
+			wantErr:  "password failed on the 'password' rule (param: 12)",
+			wantPath: "input.password",
+			wantRule: "password",
+		},
+		{
+			name:     "struct level",
+			value:    accountInput{Iban: "DE89370400440532013000", Password: "correct horse battery", From: 2, To: 1},
+			wantErr:  "from failed on the 'ltefield' rule (param: to)",
+			wantPath: "input.from",
+			wantRule: "ltefield",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+
+			err := v.Validate(ctx, tc.value)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs gqlerror.List
+			require.True(t, errors.As(err, &errs))
+			require.Len(t, errs, 1)
+			gqlErr := errs[0]
+			assert.Equal(t, tc.wantErr, gqlErr.Message)
+			assert.Equal(t, tc.wantPath, gqlErr.Path.String())
+			assert.Equal(t, tc.wantRule, gqlErr.Extensions["rule"])
+		})
+	}
+}
+
+func TestWithAllErrors(t *testing.T) {
+	RegisterArguments("Mutation", "transfer", Argument{Name: "amount", Rule: "gt=0"})
+	t.Cleanup(func() { arguments.Delete("Mutation.transfer") })
+
+	fc := &graphql.FieldContext{
+		Object: "Mutation",
+		Field: graphql.CollectedField{Field: &ast.Field{
+			Name:  "transfer",
+			Alias: "transfer",
+			Definition: &ast.FieldDefinition{
+				Name: "transfer",
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "to"},
+					{Name: "amount"},
+					{Name: "from"},
+				},
+			},
+		}},
+		Args: map[string]any{
+			"from":   &listRoot{Items: []nestedInner{{Message: "a"}, {Message: "b"}}},
+			"to":     &simpleInput{},
+			"amount": 0,
+		},
+	}
+	ctx := graphql.WithFieldContext(context.Background(), fc)
+	next := func(ctx context.Context) (any, error) { return "ok", nil }
+
+	t.Run("stops at the first failing argument", func(t *testing.T) {
+		_, err := Middleware()(ctx, next)
+
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "transfer.name", gqlErr.Path.String())
+	})
+
+	t.Run("reports every argument", func(t *testing.T) {
+		res, err := Middleware(WithAllErrors())(ctx, next)
+		assert.Nil(t, res)
+
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+
+		paths := make([]string, 0, len(errs))
+		for _, e := range errs {
+			paths = append(paths, e.Path.String())
+		}
+		assert.Equal(t, []string{
+			"transfer.name",
+			"transfer.amount",
+			"transfer.items[0].message",
+			"transfer.items[1].message",
+		}, paths)
+	})
+}
+
+func TestMiddlewareInvalidOption(t *testing.T) {
+	assert.PanicsWithError(t, "gqlgen-validate: function Key cannot be empty", func() {
+		Middleware(WithValidation("", func(validator.FieldLevel) bool { return true }))
+	})
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"fmt"
+	goruntime "runtime"
+	"strings"
+	"sync"
+)
+
+// registration is a value of a registry with the package that registered it,
+// normally the model package of a gqlgen schema.
+type registration struct {
+	pkg   string
+	value any
+}
+
+// register stores value under key, an "Object.field" of the schema. The
+// registries are shared by every schema of the binary, so a key registered by
+// another package means two schemas claim the same field, and one of them would
+// silently lose its rules.
+func register(registry *sync.Map, key string, value any) {
+	registerAs(registry, key, callerPackage(), value)
+}
+
+// registerAs registers value for pkg.
+func registerAs(registry *sync.Map, key, pkg string, value any) {
+	prev, loaded := registry.Swap(key, registration{pkg: pkg, value: value})
+	if loaded && prev.(registration).pkg != pkg {
+		panic(fmt.Sprintf("gqlgen-validate: %s is registered by both %s and %s; schemas validated in one binary must not share type and field names",
+			key, prev.(registration).pkg, pkg))
+	}
+}
+
+// load returns the value stored under key.
+func load(registry *sync.Map, key string) (any, bool) {
+	r, ok := registry.Load(key)
+	if !ok {
+		return nil, false
+	}
+	return r.(registration).value, true
+}
+
+// callerPackage returns the package calling the exported Register function
+// that called register.
+func callerPackage() string {
+	pcs := make([]uintptr, 1)
+	if goruntime.Callers(4, pcs) == 0 {
+		return ""
+	}
+	frame, _ := goruntime.CallersFrames(pcs).Next()
+
+	// github.com/org/app/graph/model.init.0 -> github.com/org/app/graph/model
+	name := frame.Function
+	slash := strings.LastIndex(name, "/") + 1
+	if dot := strings.Index(name[slash:], "."); dot >= 0 {
+		return name[:slash+dot]
+	}
+	return name
+}
@@ -0,0 +1,24 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(func() { arguments.Delete("Query.users") })
+
+	RegisterArguments("Query", "users", Argument{Name: "first", Rule: "lte=50"})
+	RegisterArguments("Query", "users", Argument{Name: "first", Rule: "lte=100"})
+	value, ok := load(&arguments, "Query.users")
+	assert.True(t, ok)
+	assert.Equal(t, []Argument{{Name: "first", Rule: "lte=100"}}, value, "a package may register a field again")
+
+	assert.PanicsWithValue(t,
+		"gqlgen-validate: Query.users is registered by both github.com/danutavadanei/gqlgen-validate/runtime and example.com/admin/graph/model; schemas validated in one binary must not share type and field names",
+		func() { registerAs(&arguments, "Query.users", "example.com/admin/graph/model", []Argument{}) })
+
+	_, ok = load(&arguments, "Query.posts")
+	assert.False(t, ok)
+}
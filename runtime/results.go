@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResultMode selects how the field middleware handles resolver results that
+// violate the @validate rule of their output field.
+type ResultMode int
+
+const (
+	// ResultError fails the field with the validation errors, the same way
+	// invalid arguments do.
+	ResultError ResultMode = iota + 1
+
+	// ResultNull logs the violations and resolves the field to null. Non-null
+	// fields then fail with gqlgen's own null error.
+	ResultNull
+
+	// ResultLog logs the violations and returns the result unchanged.
+	ResultLog
+)
+
+// ResultLogger receives the violations of a resolver result in the ResultNull
+// and ResultLog modes.
+type ResultLogger func(ctx context.Context, err *ValidationError)
+
+// results holds the registered output field rules keyed by "Object.field".
+var results sync.Map // map[string]registration of Argument
+
+// RegisterResults records the validated fields of an output object; the Name of
+// each rule is the field name. It is called by the init function of the
+// generated validatable_gen.go and panics when another package registered one of
+// the fields.
+func RegisterResults(object string, fields ...Argument) {
+	for _, field := range fields {
+		register(&results, object+"."+field.Name, field)
+	}
+}
+
+func resultFor(fc *graphql.FieldContext) (Argument, bool) {
+	if fc.Field.Field == nil {
+		return Argument{}, false
+	}
+	rule, ok := load(&results, fc.Object+"."+fc.Field.Name)
+	if !ok {
+		return Argument{}, false
+	}
+	return rule.(Argument), true
+}
+
+// logResult is the default ResultLogger.
+func logResult(ctx context.Context, err *ValidationError) {
+	slog.WarnContext(ctx, "gqlgen-validate: invalid resolver result", "path", err.Path.String(), "error", err.Error())
+}
+
+// validateResult checks the value returned by a resolver against the rule of
+// its output field and applies the ResultMode.
+func (v *Validator) validateResult(ctx context.Context, fc *graphql.FieldContext, res any) (any, error) {
+	rule, ok := resultFor(fc)
+	if !ok {
+		return res, nil
+	}
+
+	// The rule is named after the field as queried and checked from the parent
+	// path, so that violations are reported on the field itself.
+	rule.Name = fc.Field.Alias
+	pctx := resultContext(ctx, fc)
+
+	failures, err := v.checkRule(pctx, rule, res)
+	if err != nil {
+		return nil, err
+	}
+	if len(failures) == 0 {
+		return res, nil
+	}
+
+	switch v.resultMode {
+	case ResultNull:
+		v.resultLogger(ctx, newValidationError(fc.Path(), failures))
+		return nil, nil
+	case ResultLog:
+		v.resultLogger(ctx, newValidationError(fc.Path(), failures))
+		return res, nil
+	default:
+		return nil, report(ctx, v.errorsFor(ctx, fc.Path(), failures))
+	}
+}
+
+// resultContext returns ctx with the parent of the field as its field context.
+// The parent is copied, as graphql.WithFieldContext links the context it is
+// given to the current field.
+func resultContext(ctx context.Context, fc *graphql.FieldContext) context.Context {
+	var parent graphql.FieldContext
+	if fc.Parent != nil {
+		parent = *fc.Parent
+	}
+	ctx = graphql.WithFieldContext(ctx, &parent)
+	if fc.Parent != nil {
+		parent.Parent = fc.Parent.Parent
+	} else {
+		parent.Parent = nil
+	}
+	return ctx
+}
@@ -0,0 +1,138 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestResultValidation(t *testing.T) {
+	RegisterResults("User",
+		Argument{Name: "email", Rule: "email", Message: "{field} is not an email: {value}"},
+		Argument{Name: "tags", Rule: "dive,min=2"},
+	)
+	t.Cleanup(func() {
+		results.Delete("User.email")
+		results.Delete("User.tags")
+	})
+
+	// resolve runs the middleware for User.<name>, queried as alias below the
+	// user root field.
+	resolve := func(v *Validator, name, alias string, value any) (any, error) {
+		ctx := graphql.WithFieldContext(context.Background(), fieldContext("Query", "user", nil))
+		fc := fieldContext("User", name, nil)
+		fc.Field.Alias = alias
+		return resolveField(ctx, v, fc, func(context.Context) (any, error) { return value, nil })
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		v, err := New()
+		require.NoError(t, err)
+
+		res, err := resolve(v, "email", "email", "nope")
+		require.NoError(t, err)
+		assert.Equal(t, "nope", res)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		v, err := New(WithResultValidation(ResultError))
+		require.NoError(t, err)
+
+		res, err := resolve(v, "email", "email", "ada@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "ada@example.com", res)
+
+		res, err = resolve(v, "email", "mail", "nope")
+		assert.Nil(t, res)
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "mail is not an email: nope", gqlErr.Message)
+		assert.Equal(t, "user.mail", gqlErr.Path.String())
+		assert.Equal(t, "email", gqlErr.Extensions["rule"])
+	})
+
+	t.Run("root field", func(t *testing.T) {
+		RegisterResults("Query", Argument{Name: "version", Rule: "semver"})
+		t.Cleanup(func() { results.Delete("Query.version") })
+
+		v, err := New(WithResultValidation(ResultError))
+		require.NoError(t, err)
+
+		ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+			Object: "Query",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "version", Alias: "version"}},
+		})
+		_, err = v.Middleware()(ctx, func(context.Context) (any, error) { return "latest", nil })
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "version", gqlErr.Path.String())
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		v, err := New(WithResultValidation(ResultError), WithAggregatedErrors())
+		require.NoError(t, err)
+
+		_, err = resolve(v, "tags", "tags", []string{"go", "x", "y"})
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		assert.Equal(t, "user.tags", ve.Path.String())
+		require.Len(t, ve.Violations, 2)
+		assert.Equal(t, "user.tags[1]", ve.Violations[0].Path.String())
+		assert.Equal(t, "user.tags[2]", ve.Violations[1].Path.String())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var logged []*ValidationError
+		v, err := New(WithResultValidation(ResultNull), WithResultLogger(func(_ context.Context, err *ValidationError) {
+			logged = append(logged, err)
+		}))
+		require.NoError(t, err)
+
+		res, err := resolve(v, "email", "email", "nope")
+		require.NoError(t, err)
+		assert.Nil(t, res)
+		require.Len(t, logged, 1)
+		assert.Equal(t, "validation failed: user.email: email is not an email: nope", logged[0].Error())
+	})
+
+	t.Run("log", func(t *testing.T) {
+		var logged []*ValidationError
+		v, err := New(WithResultValidation(ResultLog), WithResultLogger(func(_ context.Context, err *ValidationError) {
+			logged = append(logged, err)
+		}))
+		require.NoError(t, err)
+
+		res, err := resolve(v, "email", "email", "nope")
+		require.NoError(t, err)
+		assert.Equal(t, "nope", res)
+		require.Len(t, logged, 1)
+		assert.Equal(t, "user.email", logged[0].Path.String())
+	})
+
+	t.Run("resolver errors", func(t *testing.T) {
+		v, err := New(WithResultValidation(ResultError))
+		require.NoError(t, err)
+
+		ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+			Object: "User",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "email", Alias: "email"}},
+		})
+		failure := errors.New("downstream unavailable")
+		_, err = v.Middleware()(ctx, func(context.Context) (any, error) { return nil, failure })
+		assert.Same(t, failure, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := New(WithResultValidation(ResultMode(0)))
+		assert.EqualError(t, err, "gqlgen-validate: unknown result mode 0")
+
+		_, err = New(WithResultLogger(nil))
+		assert.EqualError(t, err, "gqlgen-validate: WithResultLogger requires a logger")
+	})
+}
@@ -4,30 +4,117 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Middleware validates all resolver arguments that satisfy the validatable interface
-// after gqlgen unmarshalling.
-func Middleware() func(ctx context.Context, next graphql.Resolver) (any, error) {
-	r := newRuntime()
-	return func(ctx context.Context, next graphql.Resolver) (any, error) {
-		if fc := graphql.GetFieldContext(ctx); fc != nil {
-			for _, arg := range fc.Args {
-				if err := r.validate(ctx, arg); err != nil {
-					return nil, err
-				}
+// after gqlgen unmarshalling, as well as the scalar arguments registered with
+// RegisterArguments. It is a shorthand for New(opts...).Middleware() and panics if
+// one of the options cannot be applied.
+func Middleware(opts ...Option) func(ctx context.Context, next graphql.Resolver) (any, error) {
+	v, err := New(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v.Middleware()
+}
+
+// defaultValidator backs the package level Validate.
+var defaultValidator = sync.OnceValues(func() (*Validator, error) { return New() })
+
+// Validate validates value with a Validator without options, e.g. in resolvers
+// or tests. See Validator.Validate; errors.As retrieves the *ValidationError.
+// It fails when the schema uses struct rules that only options can register.
+func Validate(ctx context.Context, value any) error {
+	v, err := defaultValidator()
+	if err != nil {
+		return err
+	}
+	return v.Validate(ctx, value)
+}
+
+// Argument describes the @validate rule and @validateTransform operations
+// attached to a resolver argument. Rule is empty for arguments that are only
+// transformed.
+type Argument struct {
+	Name       string
+	Rule       string
+	Message    string
+	MessageKey string
+	Messages   []Message
+	Transforms []string
+}
+
+// Message is a custom error message for a single rule tag, locale or both. An
+// empty Rule applies to every tag and an empty Locale to every locale.
+type Message struct {
+	Rule   string
+	Locale string
+	Text   string
+}
+
+// arguments holds the registered argument rules keyed by "Object.field".
+var arguments sync.Map // map[string]registration of []Argument
+
+// RegisterArguments records the validated arguments of the object.field resolver.
+// It is called by the init function of the generated validatable_gen.go and
+// panics when another package registered the same field.
+func RegisterArguments(object, field string, args ...Argument) {
+	register(&arguments, object+"."+field, args)
+}
+
+func argumentsFor(fc *graphql.FieldContext) []Argument {
+	if fc.Field.Field == nil {
+		return nil
+	}
+	if args, ok := load(&arguments, fc.Object+"."+fc.Field.Name); ok {
+		return args.([]Argument)
+	}
+	return nil
+}
+
+// argumentNames lists the arguments to validate in schema order, followed by
+// registered and supplied arguments unknown to the definition in name order.
+func argumentNames(fc *graphql.FieldContext, rules []Argument) []string {
+	seen := make(map[string]struct{}, len(fc.Args))
+	names := make([]string, 0, len(fc.Args))
+	add := func(name string) {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+
+	if fc.Field.Field != nil && fc.Field.Definition != nil {
+		for _, def := range fc.Field.Definition.Arguments {
+			if _, ok := fc.Args[def.Name]; ok {
+				add(def.Name)
 			}
 		}
-		return next(ctx)
 	}
+	for _, rule := range rules {
+		add(rule.Name)
+	}
+
+	rest := make([]string, 0, len(fc.Args))
+	for name := range fc.Args {
+		if _, ok := seen[name]; !ok {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(names, rest...)
 }
 
 // validatable marks gqlgen structs that carry validation rules.
@@ -35,24 +122,63 @@ type validatable interface {
 	IsValidatable()
 }
 
-type runtime struct {
-	validator  *validator.Validate
-	fieldCache sync.Map // map[reflect.Type]map[string]*field
+// Validator validates gqlgen models with go-playground/validator. A single
+// Validator can be shared between the field middleware and code that validates
+// values outside of GraphQL, such as REST handlers or background jobs.
+type Validator struct {
+	validator     *validator.Validate
+	fieldCache    sync.Map // map[reflect.Type]map[string]*field
+	argumentTypes sync.Map // map[argumentKey]reflect.Type
+	allErrors     bool
+	translator    *ut.UniversalTranslator
+	locales       func(ctx context.Context) []string
+	dynamicOnly   bool // ignore generated validation code
+	formatter     ErrorFormatter
+	aggregate     bool
+	resultMode    ResultMode
+	resultLogger  ResultLogger
+	structFuncs   map[string]StructFunc
+	transforms    map[string]TransformFunc
+	defaults      map[string]DefaultFunc
+	asyncFuncs    map[string]AsyncFunc
+	asyncWorkers  int
+	tagCache      sync.Map // map[tagKey]bool
 }
 
 type field struct {
-	goName   string
-	jsonName string
-	message  string
-	typ      reflect.Type
+	goName     string
+	jsonName   string
+	message    string
+	messageKey string
+	messages   map[messageID]string
+	typ        reflect.Type
 }
 
-func newRuntime() *runtime {
-	v := validator.New(validator.WithRequiredStructEnabled())
+// hasMessage reports whether the field carries any custom message.
+func (f *field) hasMessage() bool {
+	return f.message != "" || f.messageKey != "" || len(f.messages) > 0
+}
+
+// New constructs a Validator configured with opts.
+func New(opts ...Option) (*Validator, error) {
+	v := newValidator()
+	for _, opt := range opts {
+		if err := opt(v); err != nil {
+			return nil, fmt.Errorf("gqlgen-validate: %w", err)
+		}
+	}
+	if err := v.checkStructRules(); err != nil {
+		return nil, fmt.Errorf("gqlgen-validate: %w", err)
+	}
+	return v, nil
+}
+
+func newValidator() *Validator {
+	engine := validator.New(validator.WithRequiredStructEnabled())
 
 	// Use the JSON tag name in error messages instead of the Go struct field name because
 	// this is the actual name used in the GraphQL schema.
-	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+	engine.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		if jsonTag := fld.Tag.Get("json"); jsonTag != "" {
 			name := strings.Split(jsonTag, ",")[0]
 			if name != "" && name != "-" {
@@ -62,84 +188,313 @@ func newRuntime() *runtime {
 		return fld.Name
 	})
 
-	return &runtime{validator: v}
+	v := &Validator{
+		validator:    engine,
+		locales:      AcceptLanguage,
+		formatter:    DefaultErrorFormatter,
+		resultLogger: logResult,
+		structFuncs:  maps.Clone(builtinStructFuncs),
+		transforms:   maps.Clone(builtinTransforms),
+		defaults:     maps.Clone(builtinDefaults),
+		asyncFuncs:   make(map[string]AsyncFunc),
+		asyncWorkers: defaultAsyncWorkers,
+	}
+	v.registerStructRules()
+	return v
+}
+
+// Engine returns the underlying go-playground validator. Registering validations
+// on it is not safe once the Validator is in use; prefer the Options of New.
+// Generated validation code does not see validations registered on it directly.
+func (v *Validator) Engine() *validator.Validate {
+	return v.validator
 }
 
-// validate runs go-playground/validator against the supplied value and maps
-// the errors into the GraphQL response.
-func (r *runtime) validate(ctx context.Context, root any) error {
-	if !isValidatable(root) {
+// Middleware returns a gqlgen field middleware that applies the
+// @validateTransform operations and @validateDefault values to the resolver
+// arguments, validates them before the resolver runs and, with
+// WithResultValidation, validates the value it returns. Fields and arguments
+// registered with RegisterSkipped are transformed and defaulted but not
+// validated.
+func (v *Validator) Middleware() func(ctx context.Context, next graphql.Resolver) (any, error) {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if fc != nil {
+			resetMutationLookups(ctx, fc)
+			if err := v.transformArguments(fc); err != nil {
+				return nil, graphql.ErrorOnPath(ctx, fmt.Errorf("gqlgen-validate: %w", err))
+			}
+			if err := v.defaultArguments(ctx, fc); err != nil {
+				return nil, graphql.ErrorOnPath(ctx, fmt.Errorf("gqlgen-validate: %w", err))
+			}
+		}
+		if fc != nil && isSkipped(fc, "") {
+			return next(ctx)
+		}
+		if fc != nil {
+			if err := v.validateArguments(ctx, fc); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := next(ctx)
+		if err != nil || fc == nil || v.resultMode == 0 {
+			return res, err
+		}
+		return v.validateResult(ctx, fc, res)
+	}
+}
+
+// Validate validates a struct outside of the field middleware. It returns a
+// gqlerror.List with one error per failing field, or a single error with
+// WithAggregatedErrors, pathed relative to the path stored in ctx, or nil when
+// the value is valid. The errors wrap a *ValidationError listing every
+// violation.
+func (v *Validator) Validate(ctx context.Context, value any) error {
+	failures, err := v.check(ctx, value, value)
+	if err != nil {
+		return err
+	}
+	if errs := v.errorsFor(ctx, graphql.GetPath(ctx), failures); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateArguments validates the resolver arguments in schema order. By default
+// it stops at the first failing argument; with WithAllErrors the errors of every
+// argument are returned together as a gqlerror.List.
+func (v *Validator) validateArguments(ctx context.Context, fc *graphql.FieldContext) error {
+	rules := argumentsFor(fc)
+
+	var all gqlerror.List
+	for _, name := range argumentNames(fc, rules) {
+		if isSkipped(fc, name) {
+			continue
+		}
+		errs, err := v.checkArgument(ctx, name, fc.Args[name], rules)
+		if err != nil {
+			return err
+		}
+		if len(errs) == 0 {
+			continue
+		}
+		if !v.allErrors {
+			return report(ctx, errs)
+		}
+		all = append(all, errs...)
+	}
+
+	if len(all) == 0 {
 		return nil
 	}
+	return all
+}
 
-	if err := r.validator.StructCtx(ctx, root); err != nil {
-		var ves validator.ValidationErrors
-		if !errors.As(err, &ves) || len(ves) == 0 {
-			return graphql.ErrorOnPath(ctx, err)
+// checkArgument validates a single resolver argument against its registered rule
+// and, for validatable inputs, against the struct tags of its model.
+func (v *Validator) checkArgument(ctx context.Context, name string, value any, rules []Argument) (gqlerror.List, error) {
+	var failures []failure
+
+	for _, rule := range rules {
+		if rule.Name != name || rule.Rule == "" {
+			continue
+		}
+		ruleFailures, err := v.checkRule(ctx, rule, value)
+		if err != nil {
+			return nil, err
 		}
+		failures = append(failures, ruleFailures...)
+	}
 
-		for i, ve := range ves {
-			pctx := r.getPathContext(ctx, root, ve)
-			msg := r.messageFor(root, ve)
+	if isValidatable(value) {
+		structFailures, err := v.check(ctx, value, value)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		failures = append(failures, structFailures...)
+	}
 
-			err = &gqlerror.Error{
-				Message: msg,
-				Path:    graphql.GetPath(pctx),
-				Extensions: map[string]any{
-					"code":  "BAD_USER_INPUT",
-					"field": ve.Field(),
-					"rule":  ve.Tag(),
-					"param": ve.Param(),
-				},
-			}
+	// Violations of input fields are pathed from the resolver field, as
+	// gqlgen reports them, so the value is pathed at the field as well.
+	return v.errorsFor(ctx, graphql.GetPath(ctx), failures), nil
+}
 
-			// If it's the last error we need to return it so that the request fails.
-			if i == len(ves)-1 {
-				return graphql.ErrorOnPath(pctx, err)
-			}
-			graphql.AddError(pctx, err)
+// failure is a failed rule with its resolved GraphQL path and message.
+type failure struct {
+	root    any
+	fe      validator.FieldError
+	path    ast.Path
+	message string
+}
+
+// check validates value and resolves the path and message of every failed rule.
+// root is reported to the ErrorFormatter; it is value itself except for scalar
+// arguments, which are validated through a wrapper struct. Other errors, such as
+// an invalid value, are returned as is.
+func (v *Validator) check(ctx context.Context, value, root any) ([]failure, error) {
+	err := v.validateAsync(ctx, value)
+	if err == nil {
+		return nil, nil
+	}
+
+	var ves validator.ValidationErrors
+	if !errors.As(err, &ves) || len(ves) == 0 {
+		return nil, err
+	}
+
+	failures := make([]failure, 0, len(ves))
+	for _, ve := range ves {
+		path := graphql.GetPath(v.getPathContext(ctx, value, ve))
+		failures = append(failures, failure{root: root, fe: ve, path: path, message: v.messageFor(ctx, value, ve, path)})
+	}
+	return failures, nil
+}
+
+// errorsFor converts the failures of a single value into GraphQL errors: one per
+// failure built by the ErrorFormatter, or with WithAggregatedErrors a single
+// error on path listing them as violations. Each error wraps the
+// ValidationError of the value.
+func (v *Validator) errorsFor(ctx context.Context, path ast.Path, failures []failure) gqlerror.List {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	ve := newValidationError(path, failures)
+	if v.aggregate {
+		return gqlerror.List{newAggregatedError(ve)}
+	}
+
+	errs := make(gqlerror.List, 0, len(failures))
+	for _, f := range failures {
+		gqlErr := v.formatter.FormatError(ctx, f.root, f.fe, f.path, f.message)
+		if gqlErr == nil {
+			gqlErr = DefaultErrorFormatter.FormatError(ctx, f.root, f.fe, f.path, f.message)
+		}
+		if gqlErr.Err == nil {
+			gqlErr.Err = ve
 		}
+		errs = append(errs, gqlErr)
 	}
+	return errs
+}
 
-	return nil
+// checkRule runs the registered rule against an argument value. The value is
+// wrapped in a single-field struct so the errors carry the argument name and are
+// reported on it.
+func (v *Validator) checkRule(ctx context.Context, arg Argument, value any) ([]failure, error) {
+	failures, err := v.check(ctx, v.argumentStruct(arg, value), value)
+	if err != nil {
+		return nil, graphql.ErrorOnPath(graphql.WithPathContext(ctx, graphql.NewPathWithField(arg.Name)), err)
+	}
+	return failures, nil
+}
+
+type argumentKey struct {
+	typ reflect.Type
+	tag reflect.StructTag
+}
+
+var anyType = reflect.TypeOf((*any)(nil)).Elem()
+
+// argumentStruct returns a struct value whose only field holds value and carries
+// the argument rule, messages and name as struct tags.
+func (v *Validator) argumentStruct(arg Argument, value any) any {
+	typ := reflect.TypeOf(value)
+	if typ == nil {
+		typ = anyType
+	}
+
+	key := argumentKey{typ: typ, tag: argumentTag(arg)}
+	st, ok := v.argumentTypes.Load(key)
+	if !ok {
+		st, _ = v.argumentTypes.LoadOrStore(key, reflect.StructOf([]reflect.StructField{
+			{Name: "Value", Type: typ, Tag: key.tag},
+		}))
+	}
+
+	rv := reflect.New(st.(reflect.Type)).Elem()
+	if value != nil {
+		rv.Field(0).Set(reflect.ValueOf(value))
+	}
+	return rv.Interface()
+}
+
+// argumentTag encodes the argument the same way the plugin tags input fields.
+func argumentTag(arg Argument) reflect.StructTag {
+	var b strings.Builder
+	fmt.Fprintf(&b, "json:%q validate:%q", arg.Name, arg.Rule)
+	if arg.Message != "" {
+		fmt.Fprintf(&b, " message:%q", arg.Message)
+	}
+	if arg.MessageKey != "" {
+		fmt.Fprintf(&b, " messageKey:%q", arg.MessageKey)
+	}
+	for _, m := range arg.Messages {
+		key := "message"
+		if m.Rule != "" {
+			key += "_" + m.Rule
+		}
+		if m.Locale != "" {
+			key += "-" + m.Locale
+		}
+		fmt.Fprintf(&b, " %s:%q", key, m.Text)
+	}
+	return reflect.StructTag(b.String())
 }
 
-func (r *runtime) getPathContext(ctx context.Context, root any, fieldError validator.FieldError) context.Context {
-	segments := strings.Split(fieldError.Namespace(), ".")
-	if len(segments) <= 1 {
+// report adds all but the last error to the response and returns the last one so
+// that the field resolution fails.
+func report(ctx context.Context, errs gqlerror.List) error {
+	for _, err := range errs[:len(errs)-1] {
+		graphql.AddError(ctx, err)
+	}
+	return errs[len(errs)-1]
+}
+
+func (v *Validator) getPathContext(ctx context.Context, root any, fieldError validator.FieldError) context.Context {
+	segments := namespaceSegments(root, fieldError.Namespace())
+	if len(segments) == 0 {
 		return ctx
 	}
-	segments = segments[1:]
 
 	pctx := ctx
 	rt := reflect.TypeOf(root)
 	rv := reflect.ValueOf(root)
 
 	for _, raw := range segments {
-		name, idx := parseSegment(raw)
+		// Struct rules are reported with an empty field name, on the struct.
+		if raw == "" {
+			continue
+		}
+		name, keys := parseSegment(raw)
 
-		jsonName, nextT, nextV := r.resolve(rt, rv, name)
+		jsonName, nextT, nextV := v.resolve(rt, rv, name)
 		if jsonName == "" {
 			jsonName = name
 		}
 		pctx = graphql.WithPathContext(pctx, graphql.NewPathWithField(jsonName))
 
 		rt, rv = nextT, nextV
-		if idx != nil {
-			rt, rv = advanceCollection(rt, rv, *idx)
-			pctx = graphql.WithPathContext(pctx, graphql.NewPathWithIndex(*idx))
+		for _, key := range keys {
+			rt, rv = advanceCollection(rt, rv, key)
+			if idx, err := strconv.Atoi(key); err == nil {
+				pctx = graphql.WithPathContext(pctx, graphql.NewPathWithIndex(idx))
+			} else {
+				pctx = graphql.WithPathContext(pctx, graphql.NewPathWithField(key))
+			}
 		}
 	}
 	return pctx
 }
 
-func (r *runtime) resolve(typ reflect.Type, value reflect.Value, goName string) (string, reflect.Type, reflect.Value) {
+func (v *Validator) resolve(typ reflect.Type, value reflect.Value, goName string) (string, reflect.Type, reflect.Value) {
 	typ = derefType(typ)
 	if typ == nil || typ.Kind() != reflect.Struct {
 		return goName, nil, reflect.Value{}
 	}
 
-	f := r.fieldFor(typ, goName)
+	f := v.fieldFor(typ, goName)
 	if f == nil {
 		return goName, nil, reflect.Value{}
 	}
@@ -156,10 +511,10 @@ func (r *runtime) resolve(typ reflect.Type, value reflect.Value, goName string)
 	return jsonName, nextTyp, reflect.Value{}
 }
 
-func (r *runtime) fieldFor(typ reflect.Type, goName string) *field {
+func (v *Validator) fieldFor(typ reflect.Type, goName string) *field {
 	typ = derefType(typ)
 
-	if cached, ok := r.fieldCache.Load(typ); ok {
+	if cached, ok := v.fieldCache.Load(typ); ok {
 		return cached.(map[string]*field)[goName]
 	}
 
@@ -177,10 +532,12 @@ func (r *runtime) fieldFor(typ reflect.Type, goName string) *field {
 		}
 
 		fld := &field{
-			goName:   f.Name,
-			jsonName: jsonName,
-			message:  f.Tag.Get("message"),
-			typ:      f.Type,
+			goName:     f.Name,
+			jsonName:   jsonName,
+			message:    f.Tag.Get("message"),
+			messageKey: f.Tag.Get("messageKey"),
+			messages:   customMessages(f.Tag),
+			typ:        f.Type,
 		}
 
 		out[f.Name] = fld
@@ -189,40 +546,115 @@ func (r *runtime) fieldFor(typ reflect.Type, goName string) *field {
 		}
 	}
 
-	r.fieldCache.Store(typ, out)
+	v.fieldCache.Store(typ, out)
 	return out[goName]
 }
 
-func (r *runtime) messageFor(root any, fieldError validator.FieldError) string {
-	if msg := r.lookupMessage(root, fieldError); msg != "" {
+// messageFor picks the message of the failing field. Messages written for the
+// failing rule win over messages for the whole field; within each, a message for
+// one of the request locales wins over a message without locale. The field's
+// messageKey translation and plain custom message come last, followed by the
+// default message. Custom and default messages are translated when translations
+// are enabled, and placeholders in custom messages are rendered for the error at
+// path.
+func (v *Validator) messageFor(ctx context.Context, root any, fieldError validator.FieldError, path ast.Path) string {
+	if fieldError.StructField() == "" {
+		return v.structMessage(ctx, root, fieldError, path)
+	}
+
+	trans := v.translatorFor(ctx)
+
+	f := v.lookupField(root, fieldError)
+	if f == nil {
+		return defaultMessage(trans, fieldError)
+	}
+
+	if len(f.messages) > 0 {
+		locales := v.requestLocales(ctx)
+		if trans != nil {
+			locales = append(locales, trans.Locale())
+		}
+
+		for _, rule := range []string{fieldError.Tag(), ""} {
+			for _, locale := range locales {
+				if msg, ok := f.messages[messageID{rule: rule, locale: locale}]; ok {
+					return renderMessage(msg, fieldError, path)
+				}
+			}
+			if msg, ok := f.messages[messageID{rule: rule}]; ok {
+				return renderMessage(translateMessage(trans, msg, fieldError), fieldError, path)
+			}
+		}
+	}
+	if f.messageKey != "" && trans != nil {
+		if msg, err := trans.T(f.messageKey, fieldError.Field(), fieldError.Param()); err == nil {
+			return renderMessage(msg, fieldError, path)
+		}
+	}
+	if f.message != "" {
+		return renderMessage(translateMessage(trans, f.message, fieldError), fieldError, path)
+	}
+	return defaultMessage(trans, fieldError)
+}
+
+// renderMessage replaces the {field}, {param}, {value}, {rule} and {path}
+// placeholders of a custom message. Unknown placeholders are kept as is.
+func renderMessage(msg string, fieldError validator.FieldError, path ast.Path) string {
+	if !strings.Contains(msg, "{") {
 		return msg
 	}
+
+	return strings.NewReplacer(
+		"{field}", fieldError.Field(),
+		"{param}", fieldError.Param(),
+		"{value}", formatValue(fieldError.Value()),
+		"{rule}", fieldError.Tag(),
+		"{path}", path.String(),
+	).Replace(msg)
+}
+
+// formatValue formats the value that failed validation, following pointers.
+// Missing values are shown as null.
+func formatValue(value any) string {
+	rv := derefValue(reflect.ValueOf(value))
+	if !rv.IsValid() {
+		return "null"
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+func defaultMessage(trans ut.Translator, fieldError validator.FieldError) string {
+	if trans != nil {
+		// Translate falls back to the raw validator error when no translation is
+		// registered for the tag.
+		if msg := fieldError.Translate(trans); msg != fieldError.Error() {
+			return msg
+		}
+	}
 	if p := fieldError.Param(); p != "" {
 		return fmt.Sprintf("%s failed on the '%s' rule (param: %s)", fieldError.Field(), fieldError.Tag(), p)
 	}
 	return fmt.Sprintf("%s failed on the '%s' rule", fieldError.Field(), fieldError.Tag())
 }
 
-func (r *runtime) lookupMessage(root any, fieldError validator.FieldError) string {
+// lookupField finds the field of root that failed validation when it carries a
+// custom message.
+func (v *Validator) lookupField(root any, fieldError validator.FieldError) *field {
 	if root == nil {
-		return ""
+		return nil
 	}
 
 	rt := derefType(reflect.TypeOf(root))
 	if rt == nil || rt.Kind() != reflect.Struct {
-		return ""
+		return nil
 	}
 
-	f := r.fieldFor(rt, fieldError.StructField())
-	if f != nil && f.message != "" {
-		return f.message
+	f := v.fieldFor(rt, fieldError.StructField())
+	if f != nil && f.hasMessage() {
+		return f
 	}
 
-	segments := strings.Split(fieldError.StructNamespace(), ".")
-	if len(segments) == 0 {
-		return ""
-	}
-	segments = segments[1:]
+	segments := namespaceSegments(root, fieldError.StructNamespace())
 
 	curr := rt
 	for i, raw := range segments {
@@ -231,24 +663,38 @@ func (r *runtime) lookupMessage(root any, fieldError validator.FieldError) strin
 			continue
 		}
 
-		f = r.fieldFor(curr, name)
+		f = v.fieldFor(curr, name)
 		if f == nil {
-			return ""
+			return nil
 		}
 		if i == len(segments)-1 {
-			return f.message
+			if f.hasMessage() {
+				return f
+			}
+			return nil
 		}
 
-		nextT := derefType(f.typ)
-		if nextT.Kind() == reflect.Slice || nextT.Kind() == reflect.Array || nextT.Kind() == reflect.Map {
-			nextT = derefType(nextT.Elem())
-		}
+		nextT := elemType(f.typ)
 		if nextT == nil || nextT.Kind() != reflect.Struct {
-			return ""
+			return nil
 		}
 		curr = nextT
 	}
-	return ""
+	return nil
+}
+
+// namespaceSegments splits a validator namespace into its field segments. The
+// namespace only starts with the type name when the root type is named.
+func namespaceSegments(root any, namespace string) []string {
+	if namespace == "" {
+		return nil
+	}
+
+	segments := strings.Split(namespace, ".")
+	if rt := derefType(reflect.TypeOf(root)); rt != nil && rt.Name() != "" {
+		segments = segments[1:]
+	}
+	return segments
 }
 
 func isValidatable(value any) bool {
@@ -264,44 +710,60 @@ func isValidatable(value any) bool {
 	return ok
 }
 
-func advanceCollection(typ reflect.Type, value reflect.Value, idx int) (reflect.Type, reflect.Value) {
+// advanceCollection steps from a list or map to the element stored under key,
+// a list index or a map key.
+func advanceCollection(typ reflect.Type, value reflect.Value, key string) (reflect.Type, reflect.Value) {
 	typ = derefType(typ)
 	value = derefValue(value)
+	if typ == nil {
+		return nil, reflect.Value{}
+	}
 
 	switch typ.Kind() {
 	case reflect.Slice, reflect.Array:
 		et := typ.Elem()
-		if value.IsValid() && idx >= 0 && idx < value.Len() {
+		if idx, err := strconv.Atoi(key); err == nil && value.IsValid() && idx >= 0 && idx < value.Len() {
 			return et, value.Index(idx)
 		}
 		return et, reflect.Value{}
 	case reflect.Map:
-		return typ.Elem(), reflect.Value{}
+		et := typ.Elem()
+		if value.IsValid() && typ.Key().Kind() == reflect.String {
+			if ev := value.MapIndex(reflect.ValueOf(key).Convert(typ.Key())); ev.IsValid() {
+				return et, ev
+			}
+		}
+		return et, reflect.Value{}
 	default:
 		return typ, value
 	}
 }
 
-func parseSegment(segment string) (string, *int) {
-	if segment == "" {
-		return "", nil
-	}
-
+// parseSegment splits a namespace segment such as matrix[0][1] into the field
+// name and the list indexes or map keys that follow it.
+func parseSegment(segment string) (string, []string) {
 	name, rest, ok := strings.Cut(segment, "[")
 	if !ok {
 		return segment, nil
 	}
 
-	rest = strings.TrimSuffix(rest, "]")
-	if rest == "" {
-		return name, nil
+	var keys []string
+	for _, key := range strings.Split(strings.TrimSuffix(rest, "]"), "][") {
+		if key != "" {
+			keys = append(keys, key)
+		}
 	}
+	return name, keys
+}
 
-	if n, err := strconv.Atoi(rest); err == nil {
-		return name, &n
+// elemType returns the element type of nested lists and maps, with pointers
+// followed.
+func elemType(t reflect.Type) reflect.Type {
+	t = derefType(t)
+	for t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map) {
+		t = derefType(t.Elem())
 	}
-
-	return name, nil
+	return t
 }
 
 func derefValue(v reflect.Value) reflect.Value {
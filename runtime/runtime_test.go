@@ -11,6 +11,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -44,6 +45,14 @@ type listRoot struct {
 
 func (listRoot) IsValidatable() {}
 
+type elementsInput struct {
+	Matrix [][]int           `json:"matrix" validate:"dive,dive,gte=0"`
+	Labels map[string]string `json:"labels" validate:"dive,max=3"`
+	Rows   [][]*nestedInner  `json:"rows" validate:"dive,dive"`
+}
+
+func (elementsInput) IsValidatable() {}
+
 type simplePointer struct {
 	Name string `json:"name" validate:"required"`
 }
@@ -97,18 +106,20 @@ func TestValidate(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			d := newRuntime()
+			d := newValidator()
 			ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
 
-			err := d.validate(ctx, tc.value)
+			err := d.Validate(ctx, tc.value)
 			if tc.wantErr == "" {
 				assert.NoError(t, err)
 				return
 			}
 
 			require.Error(t, err)
-			var gqlErr *gqlerror.Error
-			require.True(t, errors.As(err, &gqlErr))
+			var errs gqlerror.List
+			require.True(t, errors.As(err, &errs))
+			require.Len(t, errs, 1)
+			gqlErr := errs[0]
 			assert.Equal(t, tc.wantErr, gqlErr.Message)
 			if tc.wantPath != "" {
 				assert.Equal(t, tc.wantPath, gqlErr.Path.String())
@@ -117,6 +128,55 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidatorValidate(t *testing.T) {
+	v, err := New()
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, v.Validate(context.Background(), &simpleInput{Name: "Alice"}))
+	})
+
+	t.Run("reports every field without a response context", func(t *testing.T) {
+		err := v.Validate(context.Background(), &listRoot{Items: []nestedInner{{Message: "a"}, {Message: "b"}}})
+		require.Error(t, err)
+
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 2)
+		assert.Equal(t, "items[0].message", errs[0].Path.String())
+		assert.Equal(t, "items[1].message", errs[1].Path.String())
+		assert.Equal(t, "message too short", errs[0].Message)
+	})
+
+	t.Run("does not require the marker", func(t *testing.T) {
+		type plain struct {
+			Email string `json:"email" validate:"email"`
+		}
+
+		err := v.Validate(context.Background(), plain{Email: "nope"})
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "email failed on the 'email' rule", errs[0].Message)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		err := v.Validate(context.Background(), 42)
+		var invalid *validator.InvalidValidationError
+		assert.True(t, errors.As(err, &invalid))
+	})
+}
+
+func TestNew(t *testing.T) {
+	v, err := New(WithAlias("name", "min=2"))
+	require.NoError(t, err)
+	require.NotNil(t, v.Engine())
+	assert.Error(t, v.Engine().Var("a", "name"))
+
+	_, err = New(WithValidation("", func(validator.FieldLevel) bool { return true }))
+	assert.EqualError(t, err, "gqlgen-validate: function Key cannot be empty")
+}
+
 func TestIsValidatable(t *testing.T) {
 	cases := []struct {
 		name  string
@@ -138,6 +198,22 @@ func TestIsValidatable(t *testing.T) {
 	}
 }
 
+// fieldContext returns the context of the field object.name, queried without
+// an alias and resolved with args.
+func fieldContext(object, name string, args map[string]any) *graphql.FieldContext {
+	return &graphql.FieldContext{
+		Object: object,
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: name, Alias: name}},
+		Args:   args,
+	}
+}
+
+// resolveField runs the middleware of v for fc below ctx, with next standing in
+// for the resolver.
+func resolveField(ctx context.Context, v *Validator, fc *graphql.FieldContext, next graphql.Resolver) (any, error) {
+	return v.Middleware()(graphql.WithFieldContext(ctx, fc), next)
+}
+
 func TestMiddleware(t *testing.T) {
 	mw := Middleware()
 
@@ -165,7 +241,116 @@ func TestMiddleware(t *testing.T) {
 	})
 }
 
-func TestLookupMessage(t *testing.T) {
+func TestMiddlewareArguments(t *testing.T) {
+	RegisterArguments("Query", "users",
+		Argument{Name: "limit", Rule: "omitempty,gte=1,lte=100"},
+		Argument{Name: "id", Rule: "uuid4", Message: "id must be a UUID"},
+	)
+	t.Cleanup(func() { arguments.Delete("Query.users") })
+
+	mw := Middleware()
+	limit := func(n int) *int { return &n }
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		wantErr  string
+		wantPath string
+		wantRule string
+	}{
+		{
+			name: "valid",
+			args: map[string]any{"limit": limit(10), "id": "4f0c7c2e-5b9f-4b8a-9d3e-2a4f1c6b7d8e"},
+		},
+		{
+			name: "omitted optional",
+			args: map[string]any{"limit": (*int)(nil), "id": "4f0c7c2e-5b9f-4b8a-9d3e-2a4f1c6b7d8e"},
+		},
+		{
+			name:     "out of range",
+			args:     map[string]any{"limit": limit(101), "id": "4f0c7c2e-5b9f-4b8a-9d3e-2a4f1c6b7d8e"},
+			wantErr:  "limit failed on the 'lte' rule (param: 100)",
+			wantPath: "users.limit",
+			wantRule: "lte",
+		},
+		{
+			name:     "custom message",
+			args:     map[string]any{"limit": limit(1), "id": "nope"},
+			wantErr:  "id must be a UUID",
+			wantPath: "users.id",
+			wantRule: "uuid4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fc := &graphql.FieldContext{
+				Object: "Query",
+				Field:  graphql.CollectedField{Field: &ast.Field{Name: "users", Alias: "users"}},
+				Args:   tc.args,
+			}
+			ctx := graphql.WithFieldContext(context.Background(), fc)
+
+			res, err := mw(ctx, func(ctx context.Context) (any, error) { return "ok", nil })
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, "ok", res)
+				return
+			}
+
+			require.Error(t, err)
+			var gqlErr *gqlerror.Error
+			require.True(t, errors.As(err, &gqlErr))
+			assert.Equal(t, tc.wantErr, gqlErr.Message)
+			assert.Equal(t, tc.wantPath, gqlErr.Path.String())
+			assert.Equal(t, tc.wantRule, gqlErr.Extensions["rule"])
+		})
+	}
+}
+
+func TestElementPaths(t *testing.T) {
+	RegisterArguments("Query", "grid", Argument{Name: "ids", Rule: "min=1,dive,dive,uuid4"})
+	t.Cleanup(func() { arguments.Delete("Query.grid") })
+
+	v, err := New(WithAggregatedErrors())
+	require.NoError(t, err)
+
+	t.Run("input fields", func(t *testing.T) {
+		ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+		err := v.Validate(ctx, &elementsInput{
+			Matrix: [][]int{{1, 2}, {3, -4}},
+			Labels: map[string]string{"team": "core", "os": "mac"},
+			Rows:   [][]*nestedInner{{{Message: "ok"}}, {{Message: "ok"}, {Message: "x"}}},
+		})
+
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		paths := make([]string, len(ve.Violations))
+		for i, violation := range ve.Violations {
+			paths[i] = violation.Path.String()
+		}
+		assert.ElementsMatch(t, []string{"input.matrix[1][1]", "input.labels.team", "input.rows[1][1].message"}, paths)
+	})
+
+	t.Run("arguments", func(t *testing.T) {
+		fc := &graphql.FieldContext{
+			Object: "Query",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "grid", Alias: "grid"}},
+			Args:   map[string]any{"ids": [][]string{{"4f0c7c2e-5b9f-4b8a-9d3e-2a4f1c6b7d8e"}, {"4f0c7c2e-5b9f-4b8a-9d3e-2a4f1c6b7d8e", "nope"}}},
+		}
+		_, err := v.Middleware()(graphql.WithFieldContext(context.Background(), fc), func(context.Context) (any, error) {
+			return "ok", nil
+		})
+
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		require.Len(t, ve.Violations, 1)
+		assert.Equal(t, "grid.ids[1][1]", ve.Violations[0].Path.String())
+		assert.Equal(t, "uuid4", ve.Violations[0].Rule)
+	})
+}
+
+func TestLookupField(t *testing.T) {
 	type child struct {
 		Name string `json:"name" validate:"required" message:"child message"`
 	}
@@ -260,12 +445,17 @@ func TestLookupMessage(t *testing.T) {
 		{"no segments after root", root, overrideNamespace(direct, "parent"), "direct message"},
 	}
 
-	r := newRuntime()
+	r := newValidator()
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := r.lookupMessage(tc.root, tc.err)
-			assert.Equal(t, tc.want, got)
+			f := r.lookupField(tc.root, tc.err)
+			if tc.want == "" {
+				assert.Nil(t, f)
+				return
+			}
+			require.NotNil(t, f)
+			assert.Equal(t, tc.want, f.message)
 		})
 	}
 }
@@ -293,7 +483,48 @@ func TestFieldMessageTag(t *testing.T) {
 		Last  string
 	}{})
 
-	r := newRuntime()
+	r := newValidator()
 	assert.Equal(t, "first", r.fieldFor(typ, "First").message)
 	assert.Nil(t, r.fieldFor(typ, "Missing"))
 }
+
+type templatedInput struct {
+	Password string      `json:"password" validate:"min=8" message:"{field} must be at least {param} characters (got {value})"`
+	Age      *int        `json:"age" validate:"required" message:"{path} failed {rule} with {value}, {unknown} kept"`
+	Items    []templated `json:"items" validate:"dive"`
+}
+
+type templated struct {
+	Code string `json:"code" validate:"len=3" message:"{path}: {value} is not {param} long"`
+}
+
+func (templatedInput) IsValidatable() {}
+
+func TestMessagePlaceholders(t *testing.T) {
+	r := newValidator()
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+
+	var errs gqlerror.List
+	require.True(t, errors.As(r.Validate(ctx, &templatedInput{
+		Password: "abc",
+		Items:    []templated{{Code: "ABC"}, {Code: "ABCD"}},
+	}), &errs))
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Message)
+	}
+	assert.Equal(t, []string{
+		"password must be at least 8 characters (got abc)",
+		"input.age failed required with null, {unknown} kept",
+		"input.items[1].code: ABCD is not 3 long",
+	}, messages)
+
+	t.Run("arguments", func(t *testing.T) {
+		limit := 500
+		errs, err := r.checkRule(ctx, Argument{Name: "limit", Rule: "lte=100", Message: "{field} must be at most {param}, got {value}"}, &limit)
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "limit must be at most 100, got 500", errs[0].message)
+	})
+}
@@ -0,0 +1,40 @@
+package runtime
+
+import (
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// skipped holds the resolver fields and arguments marked with @skipValidate,
+// keyed by "Object.field" and "Object.field.argument".
+var skipped sync.Map // map[string]registration of struct{}
+
+// RegisterSkipped records that the middleware does not validate the object.field
+// resolver at all or, when arguments are given, only those arguments. It is
+// called by the init function of the generated validatable_gen.go and panics
+// when another package registered the same field or argument.
+func RegisterSkipped(object, field string, arguments ...string) {
+	if len(arguments) == 0 {
+		register(&skipped, object+"."+field, struct{}{})
+		return
+	}
+	for _, argument := range arguments {
+		register(&skipped, object+"."+field+"."+argument, struct{}{})
+	}
+}
+
+// isSkipped reports whether the field of fc or, with a non-empty argument, that
+// argument of it is excluded from validation.
+func isSkipped(fc *graphql.FieldContext, argument string) bool {
+	if fc.Field.Field == nil {
+		return false
+	}
+
+	key := fc.Object + "." + fc.Field.Name
+	if argument != "" {
+		key += "." + argument
+	}
+	_, ok := load(&skipped, key)
+	return ok
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestSkipped(t *testing.T) {
+	RegisterArguments("Mutation", "importUsers", Argument{Name: "limit", Rule: "lte=100"})
+	RegisterArguments("Mutation", "register", Argument{Name: "limit", Rule: "lte=100"})
+	RegisterResults("Mutation", Argument{Name: "importUsers", Rule: "email"})
+	RegisterSkipped("Mutation", "importUsers")
+	RegisterSkipped("Mutation", "register", "input")
+	t.Cleanup(func() {
+		arguments.Delete("Mutation.importUsers")
+		arguments.Delete("Mutation.register")
+		results.Delete("Mutation.importUsers")
+		skipped.Delete("Mutation.importUsers")
+		skipped.Delete("Mutation.register.input")
+	})
+
+	v, err := New(WithResultValidation(ResultError))
+	require.NoError(t, err)
+
+	resolve := func(name string, args map[string]any) (any, error) {
+		return resolveField(context.Background(), v, fieldContext("Mutation", name, args), func(context.Context) (any, error) {
+			return "not an email", nil
+		})
+	}
+
+	t.Run("field", func(t *testing.T) {
+		res, err := resolve("importUsers", map[string]any{"limit": 1000, "input": &simpleInput{}})
+		require.NoError(t, err)
+		assert.Equal(t, "not an email", res)
+	})
+
+	t.Run("argument", func(t *testing.T) {
+		_, err := resolve("register", map[string]any{"limit": 10, "input": &simpleInput{}})
+		require.NoError(t, err)
+
+		_, err = resolve("register", map[string]any{"limit": 1000, "input": &simpleInput{}})
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "register.limit", gqlErr.Path.String())
+	})
+}
@@ -0,0 +1,134 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// StaticValidator is implemented by the models for which the plugin generated
+// validation code (gen.WithStaticValidation). The generated Validate method
+// returns validator.ValidationErrors, or nil when the model is valid.
+type StaticValidator interface {
+	Validate(ctx context.Context) error
+}
+
+// staticTags lists the built-in tags the plugin compiles to Go code. Overriding
+// one of them with WithValidation disables the generated code. It must match
+// the tags supported by the gen package.
+var staticTags = map[string]struct{}{
+	"-": {}, "required": {}, "omitempty": {}, "omitnil": {}, "dive": {},
+	"min": {}, "max": {}, "len": {}, "eq": {}, "ne": {}, "gt": {}, "gte": {}, "lt": {}, "lte": {},
+	"oneof": {}, "eqfield": {}, "nefield": {},
+	"required_with": {}, "required_with_all": {}, "required_without": {}, "required_without_all": {},
+	"excluded_with": {}, "excluded_with_all": {}, "excluded_without": {}, "excluded_without_all": {},
+	"alpha": {}, "alphanum": {}, "numeric": {}, "number": {}, "hexadecimal": {}, "email": {},
+	"e164": {}, "uuid": {}, "uuid4": {},
+}
+
+// Patterns of the string formats supported by MatchFormat, copied from
+// go-playground/validator so that generated code accepts exactly the same values.
+var formats = map[string]*regexp.Regexp{
+	"alpha":       regexp.MustCompile("^[a-zA-Z]+$"),
+	"alphanum":    regexp.MustCompile("^[a-zA-Z0-9]+$"),
+	"numeric":     regexp.MustCompile("^[-+]?[0-9]+(?:\\.[0-9]+)?$"),
+	"number":      regexp.MustCompile("^[0-9]+$"),
+	"hexadecimal": regexp.MustCompile("^(0[xX])?[0-9a-fA-F]+$"),
+	"email":       regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"),
+	"e164":        regexp.MustCompile("^\\+[1-9]?[0-9]{7,14}$"),
+	"uuid":        regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"),
+	"uuid4":       regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"),
+}
+
+// MatchFormat reports whether value satisfies the string format tag (alpha,
+// alphanum, numeric, number, hexadecimal, email, e164, uuid or uuid4). It is
+// used by generated validation code and panics on other tags.
+func MatchFormat(tag, value string) bool {
+	re, ok := formats[tag]
+	if !ok {
+		panic(fmt.Sprintf("gqlgen-validate: unsupported format %q", tag))
+	}
+	if tag == "email" {
+		if _, err := mail.ParseAddress(value); err != nil {
+			return false
+		}
+	}
+	return re.MatchString(value)
+}
+
+// NewFieldError builds the validator.FieldError reported by generated
+// validation code. namespace and structNamespace are the dotted paths of the
+// field using GraphQL and Go names respectively, including the root type name.
+func NewFieldError(tag, param, namespace, structNamespace string, value any) validator.FieldError {
+	return &fieldError{tag: tag, param: param, ns: namespace, structNs: structNamespace, value: value}
+}
+
+// fieldError implements validator.FieldError for generated validation code.
+type fieldError struct {
+	tag      string
+	param    string
+	ns       string
+	structNs string
+	value    any
+}
+
+func (e *fieldError) Tag() string             { return e.tag }
+func (e *fieldError) ActualTag() string       { return e.tag }
+func (e *fieldError) Namespace() string       { return e.ns }
+func (e *fieldError) StructNamespace() string { return e.structNs }
+func (e *fieldError) Field() string           { return lastSegment(e.ns) }
+func (e *fieldError) StructField() string     { return lastSegment(e.structNs) }
+func (e *fieldError) Value() any              { return e.value }
+func (e *fieldError) Param() string           { return e.param }
+
+func (e *fieldError) Kind() reflect.Kind {
+	return derefValue(reflect.ValueOf(e.value)).Kind()
+}
+
+func (e *fieldError) Type() reflect.Type {
+	return reflect.TypeOf(e.value)
+}
+
+// Translate uses the translation registered under the tag name and falls back
+// to Error. Translations that depend on the field kind, such as min, are not
+// available; the Validator re-runs go-playground when translations are enabled.
+func (e *fieldError) Translate(trans ut.Translator) string {
+	if trans != nil {
+		if msg, err := trans.T(e.tag, e.Field(), e.param); err == nil {
+			return msg
+		}
+	}
+	return e.Error()
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.ns, e.Field(), e.tag)
+}
+
+func lastSegment(namespace string) string {
+	return namespace[strings.LastIndexByte(namespace, '.')+1:]
+}
+
+// validate runs the generated validation code of root when it is available and
+// go-playground otherwise. Struct level validations and overridden built-in
+// tags are only known to go-playground, so they disable the generated code.
+func (v *Validator) validate(ctx context.Context, root any) error {
+	sv, ok := root.(StaticValidator)
+	if !ok || v.dynamicOnly || !isValidatable(root) {
+		return v.validator.StructCtx(ctx, root)
+	}
+
+	err := sv.Validate(ctx)
+	if err != nil && v.translator != nil {
+		// Default messages are translated per field kind, which only
+		// go-playground knows about.
+		return v.validator.StructCtx(ctx, root)
+	}
+	return err
+}
@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// staticInput mimics a model with generated validation code. Its Validate
+// method reports a distinct tag so tests can tell which path ran.
+type staticInput struct {
+	Name string `json:"name" validate:"min=3"`
+}
+
+func (staticInput) IsValidatable() {}
+
+func (m staticInput) Validate(context.Context) error {
+	if len(m.Name) >= 3 {
+		return nil
+	}
+	return validator.ValidationErrors{
+		NewFieldError("static", "3", "staticInput.name", "staticInput.Name", m.Name),
+	}
+}
+
+func TestValidatorPrefersGeneratedCode(t *testing.T) {
+	ruleOf := func(t *testing.T, v *Validator) string {
+		t.Helper()
+
+		err := v.Validate(context.Background(), staticInput{Name: "a"})
+		var errs gqlerror.List
+		require.True(t, errors.As(err, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "name", errs[0].Path.String())
+		return errs[0].Extensions["rule"].(string)
+	}
+
+	t.Run("generated", func(t *testing.T) {
+		v, err := New()
+		require.NoError(t, err)
+		assert.Equal(t, "static", ruleOf(t, v))
+		assert.NoError(t, v.Validate(context.Background(), staticInput{Name: "abc"}))
+	})
+
+	t.Run("overridden built-in tag", func(t *testing.T) {
+		v, err := New(WithValidation("min", func(validator.FieldLevel) bool { return false }))
+		require.NoError(t, err)
+		assert.Equal(t, "min", ruleOf(t, v))
+	})
+
+	t.Run("struct level validation", func(t *testing.T) {
+		v, err := New(WithStructLevel(func(validator.StructLevel) {}, staticInput{}))
+		require.NoError(t, err)
+		assert.Equal(t, "min", ruleOf(t, v))
+	})
+
+	t.Run("struct rules", func(t *testing.T) {
+		t.Cleanup(func() { structRules.Delete(reflect.TypeOf(staticInput{})) })
+
+		RegisterStructRules(staticInput{}, StructRule{Name: "oneOf", Param: "name"})
+		v, err := New()
+		require.NoError(t, err)
+		assert.Equal(t, "static", ruleOf(t, v), "generated code implements oneOf")
+
+		RegisterStructRules(staticInput{}, StructRule{Name: "oneOf", Param: "name"}, StructRule{Name: "required_any", Param: "name"})
+		v, err = New()
+		require.NoError(t, err)
+		assert.Equal(t, "min", ruleOf(t, v))
+	})
+
+	t.Run("custom tag", func(t *testing.T) {
+		v, err := New(WithValidation("iban", func(validator.FieldLevel) bool { return false }))
+		require.NoError(t, err)
+		assert.Equal(t, "static", ruleOf(t, v))
+	})
+
+	t.Run("translations", func(t *testing.T) {
+		v, err := New(WithTranslations("en"))
+		require.NoError(t, err)
+		assert.Equal(t, "min", ruleOf(t, v))
+	})
+}
+
+func TestNewFieldError(t *testing.T) {
+	value := 5
+	fe := NewFieldError("gte", "18", "Input.items[0].age", "Input.Items[0].Age", &value)
+
+	assert.Equal(t, "gte", fe.Tag())
+	assert.Equal(t, "gte", fe.ActualTag())
+	assert.Equal(t, "18", fe.Param())
+	assert.Equal(t, "Input.items[0].age", fe.Namespace())
+	assert.Equal(t, "Input.Items[0].Age", fe.StructNamespace())
+	assert.Equal(t, "age", fe.Field())
+	assert.Equal(t, "Age", fe.StructField())
+	assert.Equal(t, &value, fe.Value())
+	assert.Equal(t, reflect.Int, fe.Kind())
+	assert.Equal(t, reflect.TypeOf(&value), fe.Type())
+	assert.Equal(t, "Key: 'Input.items[0].age' Error:Field validation for 'age' failed on the 'gte' tag", fe.Error())
+	assert.Equal(t, fe.Error(), fe.Translate(nil))
+}
+
+func TestMatchFormat(t *testing.T) {
+	assert.True(t, MatchFormat("email", "ada@example.com"))
+	assert.False(t, MatchFormat("email", "Ada <ada@example.com>"))
+	assert.True(t, MatchFormat("uuid4", "9b2c3d4e-1f2a-4b3c-8d4e-5f6a7b8c9d0e"))
+	assert.False(t, MatchFormat("uuid4", "9b2c3d4e-1f2a-1b3c-8d4e-5f6a7b8c9d0e"))
+	assert.True(t, MatchFormat("e164", "+14155552671"))
+	assert.Panics(t, func() { MatchFormat("url", "https://example.com") })
+}
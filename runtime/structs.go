@@ -0,0 +1,323 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// StructRule describes a @validateStruct rule attached to an input object. Param
+// references fields by their GraphQL names.
+type StructRule struct {
+	Name    string
+	Param   string
+	Message string
+}
+
+// StructFunc implements a struct rule. It reports whether the input object in
+// sl.Current() satisfies the rule; param is the parameter written in the schema.
+type StructFunc func(ctx context.Context, sl validator.StructLevel, param string) bool
+
+// structRules holds the registered struct rules keyed by model type.
+var structRules sync.Map // map[reflect.Type][]StructRule
+
+// RegisterStructRules records the struct rules of an input model, e.g.
+// model.DateRangeInput{}. It is called by the init function of the generated
+// validatable_gen.go; Validators created before do not see the rules.
+func RegisterStructRules(model any, rules ...StructRule) {
+	structRules.Store(derefType(reflect.TypeOf(model)), rules)
+}
+
+// builtinStructFuncs implements the struct rules known to the plugin.
+var builtinStructFuncs = map[string]StructFunc{
+	"oneOf":        oneOf,
+	"required_any": requiredAny,
+	"ordered":      ordered,
+	"sum":          sum,
+}
+
+// registerStructRules wires the registered struct rules into the engine.
+// Generated validation code only implements the oneOf rule, so types carrying
+// other rules have none, but a type registered by hand might, and that code
+// would skip the rules.
+func (v *Validator) registerStructRules() {
+	staticValidatorType := reflect.TypeOf((*StaticValidator)(nil)).Elem()
+
+	structRules.Range(func(key, value any) bool {
+		typ, rules := key.(reflect.Type), value.([]StructRule)
+		v.validator.RegisterStructValidationCtx(v.structLevel(rules), reflect.Zero(typ).Interface())
+		if typ.Implements(staticValidatorType) && slices.ContainsFunc(rules, func(rule StructRule) bool { return rule.Name != "oneOf" }) {
+			v.dynamicOnly = true
+		}
+		return true
+	})
+}
+
+// checkStructRules reports the registered struct rules that have no function,
+// e.g. a rule accepted by gen.WithStructRules but not passed to WithStructRule.
+func (v *Validator) checkStructRules() error {
+	var undefined []string
+	structRules.Range(func(key, value any) bool {
+		for _, rule := range value.([]StructRule) {
+			if _, ok := v.structFuncs[rule.Name]; !ok {
+				undefined = append(undefined, fmt.Sprintf("%q (%s)", rule.Name, key.(reflect.Type)))
+			}
+		}
+		return true
+	})
+	if len(undefined) == 0 {
+		return nil
+	}
+	sort.Strings(undefined)
+	return fmt.Errorf("undefined struct rules %s; register them with WithStructRule", strings.Join(undefined, ", "))
+}
+
+// structLevel runs rules against a struct and reports every failing rule on the
+// struct itself. New rejects rules without a function, so none are skipped.
+func (v *Validator) structLevel(rules []StructRule) validator.StructLevelFuncCtx {
+	return func(ctx context.Context, sl validator.StructLevel) {
+		for _, rule := range rules {
+			fn, ok := v.structFuncs[rule.Name]
+			if ok && !fn(ctx, sl, rule.Param) {
+				sl.ReportError(sl.Current().Interface(), "", "", rule.Name, rule.Param)
+			}
+		}
+	}
+}
+
+// structMessage picks the message of a failed struct rule: the custom message of
+// the rule, the translation of its tag or a default naming the type.
+func (v *Validator) structMessage(ctx context.Context, root any, fieldError validator.FieldError, path ast.Path) string {
+	trans := v.translatorFor(ctx)
+
+	typ := v.structType(root, fieldError)
+	if rules, ok := structRules.Load(typ); ok {
+		for _, rule := range rules.([]StructRule) {
+			if rule.Name == fieldError.Tag() && rule.Param == fieldError.Param() && rule.Message != "" {
+				return renderMessage(translateMessage(trans, rule.Message, fieldError), fieldError, path)
+			}
+		}
+	}
+
+	if trans != nil {
+		if msg := fieldError.Translate(trans); msg != fieldError.Error() {
+			return msg
+		}
+	}
+	if fieldError.Tag() == "oneOf" {
+		return fmt.Sprintf("exactly one of %s must be set", strings.Join(strings.Fields(fieldError.Param()), ", "))
+	}
+	name := "input"
+	if typ != nil && typ.Name() != "" {
+		name = typ.Name()
+	}
+	if p := fieldError.Param(); p != "" {
+		return fmt.Sprintf("%s failed on the '%s' rule (param: %s)", name, fieldError.Tag(), p)
+	}
+	return fmt.Sprintf("%s failed on the '%s' rule", name, fieldError.Tag())
+}
+
+// structType returns the type of the struct a struct level error was reported
+// on, or nil when it cannot be resolved.
+func (v *Validator) structType(root any, fieldError validator.FieldError) reflect.Type {
+	curr := derefType(reflect.TypeOf(root))
+	for _, raw := range namespaceSegments(root, fieldError.StructNamespace()) {
+		name, _ := parseSegment(raw)
+		if name == "" {
+			continue
+		}
+		if curr == nil || curr.Kind() != reflect.Struct {
+			return nil
+		}
+
+		f := v.fieldFor(curr, name)
+		if f == nil {
+			return nil
+		}
+		curr = elemType(f.typ)
+	}
+	return curr
+}
+
+// StructField returns the field of the struct in sl.Current() with the given
+// GraphQL or Go name, with pointers followed. It returns the zero Value when the
+// field does not exist or is nil.
+func StructField(sl validator.StructLevel, name string) reflect.Value {
+	current := derefValue(sl.Current())
+	if !current.IsValid() || current.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	typ := current.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.Name == name || jsonName == name {
+			return derefValue(current.Field(i))
+		}
+	}
+	return reflect.Value{}
+}
+
+// isSet reports whether a field holds a value: non-nil and, except for
+// collections, non-zero; collections must not be empty.
+func isSet(value reflect.Value) bool {
+	if !value.IsValid() {
+		return false
+	}
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() > 0
+	case reflect.Interface:
+		return !value.IsNil()
+	default:
+		return !value.IsZero()
+	}
+}
+
+// oneOf passes when exactly one of the fields in param is not null, as required
+// for OneOf input objects.
+func oneOf(_ context.Context, sl validator.StructLevel, param string) bool {
+	n := 0
+	for _, name := range strings.Fields(param) {
+		value := StructField(sl, name)
+		if !value.IsValid() {
+			continue
+		}
+		switch value.Kind() {
+		case reflect.Slice, reflect.Map, reflect.Interface:
+			if value.IsNil() {
+				continue
+			}
+		}
+		n++
+	}
+	return n == 1
+}
+
+// requiredAny passes when at least one of the fields in param is set.
+func requiredAny(_ context.Context, sl validator.StructLevel, param string) bool {
+	for _, name := range strings.Fields(param) {
+		if isSet(StructField(sl, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ordered passes when the fields in param that are not nil are in strictly
+// ascending order. Numbers, strings and time.Time values can be compared.
+func ordered(_ context.Context, sl validator.StructLevel, param string) bool {
+	var prev reflect.Value
+	for _, name := range strings.Fields(param) {
+		value := StructField(sl, name)
+		if !value.IsValid() {
+			continue
+		}
+		if prev.IsValid() {
+			c, ok := compareValues(prev, value)
+			if !ok || c >= 0 {
+				return false
+			}
+		}
+		prev = value
+	}
+	return true
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// compareValues compares two values of the same kind. ok is false when they
+// cannot be compared.
+func compareValues(a, b reflect.Value) (c int, ok bool) {
+	if a.Type() == timeType && b.Type() == timeType {
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time)), true
+	}
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return strings.Compare(a.String(), b.String()), true
+	}
+
+	x, okA := numberOf(a)
+	y, okB := numberOf(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func numberOf(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// sum passes when the numeric fields in param, after the expected total, add up
+// to the total. List fields contribute the sum of their elements and nil values
+// count as zero.
+func sum(_ context.Context, sl validator.StructLevel, param string) bool {
+	fields := strings.Fields(param)
+	if len(fields) == 0 {
+		return false
+	}
+	total, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return false
+	}
+
+	var got float64
+	for _, name := range fields[1:] {
+		value := StructField(sl, name)
+		if !value.IsValid() {
+			continue
+		}
+
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				elem := derefValue(value.Index(i))
+				if !elem.IsValid() {
+					continue
+				}
+				n, ok := numberOf(elem)
+				if !ok {
+					return false
+				}
+				got += n
+			}
+			continue
+		}
+
+		n, ok := numberOf(value)
+		if !ok {
+			return false
+		}
+		got += n
+	}
+	return math.Abs(got-total) < 1e-9
+}
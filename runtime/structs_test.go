@@ -0,0 +1,230 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type contactInput struct {
+	Email *string `json:"email"`
+	Phone *string `json:"phone"`
+}
+
+func (contactInput) IsValidatable() {}
+
+type periodInput struct {
+	Start *time.Time `json:"startDate"`
+	End   *time.Time `json:"endDate"`
+	Split []int      `json:"split"`
+	Rest  *int       `json:"rest"`
+}
+
+type transferInput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (transferInput) IsValidatable() {}
+
+type answerInput struct {
+	ID      *string  `json:"id"`
+	Text    *string  `json:"text"`
+	Choices []string `json:"choices"`
+}
+
+func (answerInput) IsValidatable() {}
+
+type scheduleInput struct {
+	Periods []periodInput `json:"periods" validate:"dive"`
+}
+
+func (scheduleInput) IsValidatable() {}
+
+func TestStructRules(t *testing.T) {
+	RegisterStructRules(contactInput{},
+		StructRule{Name: "required_any", Param: "email phone", Message: "provide an email or a phone number"},
+	)
+	RegisterStructRules(&periodInput{},
+		StructRule{Name: "ordered", Param: "startDate endDate"},
+		StructRule{Name: "sum", Param: "100 split rest", Message: "{rule} of the shares must be {param}"},
+	)
+	t.Cleanup(func() {
+		structRules.Delete(reflect.TypeOf(contactInput{}))
+		structRules.Delete(reflect.TypeOf(periodInput{}))
+	})
+
+	v, err := New()
+	require.NoError(t, err)
+
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+	phone := "+4917612345678"
+
+	t.Run("required_any", func(t *testing.T) {
+		assert.NoError(t, v.Validate(ctx, &contactInput{Phone: &phone}))
+
+		err := v.Validate(ctx, &contactInput{})
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		assert.Equal(t, "validation failed: input: provide an email or a phone number", ve.Error())
+
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "input", gqlErr.Path.String())
+		assert.Equal(t, "required_any", gqlErr.Extensions["rule"])
+		assert.Equal(t, "email phone", gqlErr.Extensions["param"])
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		day := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+		before := day.Add(-time.Hour)
+		rest := 40
+
+		err := v.Validate(ctx, &scheduleInput{Periods: []periodInput{
+			{Start: &before, End: &day, Split: []int{60}, Rest: &rest},
+			{Start: &day, End: &before, Split: []int{50, 10}},
+			{Start: &day},
+		}})
+
+		var ve *ValidationError
+		require.True(t, errors.As(err, &ve))
+		require.Len(t, ve.Violations, 3)
+		assert.Equal(t, Violation{
+			Path:    ast.Path{ast.PathName("input"), ast.PathName("periods"), ast.PathIndex(1)},
+			Rule:    "ordered",
+			Param:   "startDate endDate",
+			Message: "periodInput failed on the 'ordered' rule (param: startDate endDate)",
+		}, withoutFieldErrors(ve.Violations)[0])
+		assert.Equal(t, "input.periods[1]", ve.Violations[1].Path.String())
+		assert.Equal(t, "sum of the shares must be 100 split rest", ve.Violations[1].Message)
+		assert.Equal(t, "input.periods[2]", ve.Violations[2].Path.String())
+		assert.Equal(t, "sum", ve.Violations[2].Rule)
+	})
+
+	t.Run("oneOf", func(t *testing.T) {
+		RegisterStructRules(answerInput{}, StructRule{Name: "oneOf", Param: "id text choices"})
+		t.Cleanup(func() { structRules.Delete(reflect.TypeOf(answerInput{})) })
+
+		v, err := New()
+		require.NoError(t, err)
+
+		empty := ""
+		assert.NoError(t, v.Validate(ctx, &answerInput{Text: &empty}))
+		assert.NoError(t, v.Validate(ctx, &answerInput{Choices: []string{}}))
+
+		for _, input := range []*answerInput{{}, {ID: &phone, Text: &empty}} {
+			var gqlErr *gqlerror.Error
+			require.True(t, errors.As(v.Validate(ctx, input), &gqlErr))
+			assert.Equal(t, "exactly one of id, text, choices must be set", gqlErr.Message)
+			assert.Equal(t, "input", gqlErr.Path.String())
+			assert.Equal(t, "oneOf", gqlErr.Extensions["rule"])
+		}
+	})
+
+	t.Run("middleware", func(t *testing.T) {
+		fc := &graphql.FieldContext{
+			Object: "Mutation",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "contact", Alias: "contact"}},
+			Args:   map[string]any{"input": &contactInput{}},
+		}
+		_, err := v.Middleware()(graphql.WithFieldContext(context.Background(), fc), func(context.Context) (any, error) {
+			return "ok", nil
+		})
+
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "provide an email or a phone number", gqlErr.Message)
+		assert.Equal(t, "contact", gqlErr.Path.String())
+	})
+
+	t.Run("custom rule", func(t *testing.T) {
+		RegisterStructRules(transferInput{}, StructRule{Name: "distinct", Param: "from to"})
+		t.Cleanup(func() { structRules.Delete(reflect.TypeOf(transferInput{})) })
+
+		v, err := New(WithStructRule("distinct", func(_ context.Context, sl validator.StructLevel, param string) bool {
+			assert.Equal(t, "from to", param)
+			return StructField(sl, "from").String() != StructField(sl, "To").String()
+		}))
+		require.NoError(t, err)
+
+		assert.NoError(t, v.Validate(ctx, &transferInput{From: "DE01", To: "DE02"}))
+
+		var ve *ValidationError
+		require.True(t, errors.As(v.Validate(ctx, &transferInput{From: "DE01", To: "DE01"}), &ve))
+		assert.Equal(t, "distinct", ve.Violations[0].Rule)
+		assert.Equal(t, "input", ve.Violations[0].Path.String())
+		assert.Equal(t, "transferInput failed on the 'distinct' rule (param: from to)", ve.Violations[0].Message)
+	})
+
+	t.Run("undefined rule", func(t *testing.T) {
+		RegisterStructRules(transferInput{}, StructRule{Name: "unknown"})
+		t.Cleanup(func() { structRules.Delete(reflect.TypeOf(transferInput{})) })
+
+		_, err := New()
+		assert.EqualError(t, err, `gqlgen-validate: undefined struct rules "unknown" (runtime.transferInput); register them with WithStructRule`)
+	})
+
+	t.Run("requires a function", func(t *testing.T) {
+		_, err := New(WithStructRule("x", nil))
+		assert.EqualError(t, err, "gqlgen-validate: WithStructRule requires a function")
+	})
+}
+
+func TestStructRuleFuncs(t *testing.T) {
+	one, two := 1, 2
+	day := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		fn    StructFunc
+		value any
+		param string
+		want  bool
+	}{
+		{"oneOf none", oneOf, struct{ A, B *int }{}, "A B", false},
+		{"oneOf zero value", oneOf, struct{ A, B *int }{A: ptr(0)}, "A B", true},
+		{"oneOf both", oneOf, struct{ A, B *int }{A: &one, B: &two}, "A B", false},
+		{"oneOf nil list", oneOf, struct {
+			A []int
+			B *int
+		}{B: &one}, "A B", true},
+		{"required_any none", requiredAny, struct{ A, B *int }{}, "A B", false},
+		{"required_any pointer", requiredAny, struct{ A, B *int }{B: &one}, "A B", true},
+		{"required_any empty list", requiredAny, struct{ A []int }{A: []int{}}, "A", false},
+		{"ordered numbers", ordered, struct{ A, B, C *int }{A: &one, C: &two}, "A B C", true},
+		{"ordered equal", ordered, struct{ A, B int }{A: 1, B: 1}, "A B", false},
+		{"ordered strings", ordered, struct{ A, B string }{A: "a", B: "b"}, "A B", true},
+		{"ordered times", ordered, struct{ A, B time.Time }{A: day.Add(time.Hour), B: day}, "A B", false},
+		{"ordered mixed", ordered, struct {
+			A int
+			B string
+		}{A: 1, B: "2"}, "A B", false},
+		{"sum", sum, struct {
+			A []*float64
+			B *int
+		}{A: []*float64{ptr(33.3), nil, ptr(16.7)}, B: ptr(50)}, "100 A B", true},
+		{"sum mismatch", sum, struct{ A, B int }{A: 1, B: 2}, "4 A B", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got bool
+			engine := validator.New()
+			engine.RegisterStructValidationCtx(func(ctx context.Context, sl validator.StructLevel) {
+				got = tc.fn(ctx, sl, tc.param)
+			}, tc.value)
+			_ = engine.Struct(tc.value)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
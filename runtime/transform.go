@@ -0,0 +1,190 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/99designs/gqlgen/graphql"
+	"golang.org/x/text/unicode/norm"
+)
+
+// transformTag is the struct tag listing the @validateTransform operations of
+// an input field, e.g. transform:"trim,lower".
+const transformTag = "transform"
+
+// TransformFunc normalizes a string value for @validateTransform.
+type TransformFunc func(string) string
+
+// builtinTransforms implements the transforms known to the plugin.
+var builtinTransforms = map[string]TransformFunc{
+	"trim":     strings.TrimSpace,
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"nfc":      norm.NFC.String,
+	"collapse": collapseSpaces,
+}
+
+// collapseSpaces replaces every run of white space with a single space.
+func collapseSpaces(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+		}
+		space = true
+	}
+	return b.String()
+}
+
+// transformArguments applies the @validateTransform operations of the resolver
+// arguments and of the input fields they contain to fc.Args, so that both the
+// validation and the resolver see the normalized values. Only strings are
+// transformed, including those in lists and maps; other values are left alone.
+func (v *Validator) transformArguments(fc *graphql.FieldContext) error {
+	rules := argumentsFor(fc)
+	for name, value := range fc.Args {
+		var ops []string
+		for _, rule := range rules {
+			if rule.Name == name {
+				ops = append(ops, rule.Transforms...)
+			}
+		}
+
+		rv := reflect.ValueOf(value)
+		if !rv.IsValid() || (len(ops) == 0 && !v.hasTag(rv.Type(), transformTag)) {
+			continue
+		}
+
+		// Values stored in the map are not addressable; transform a copy.
+		out := reflect.New(rv.Type()).Elem()
+		out.Set(rv)
+		if err := v.transform(out, ops); err != nil {
+			return fmt.Errorf("argument %s: %w", name, err)
+		}
+		fc.Args[name] = out.Interface()
+	}
+	return nil
+}
+
+// transform applies ops to the strings of the addressable value rv and the
+// transform tags to the fields of the input models it contains.
+func (v *Validator) transform(rv reflect.Value, ops []string) error {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil
+		}
+		return v.transform(rv.Elem(), ops)
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		elem := reflect.New(rv.Elem().Type()).Elem()
+		elem.Set(rv.Elem())
+		if err := v.transform(elem, ops); err != nil {
+			return err
+		}
+		rv.Set(elem)
+	case reflect.String:
+		if len(ops) == 0 {
+			return nil
+		}
+		s := rv.String()
+		for _, op := range ops {
+			fn, ok := v.transforms[op]
+			if !ok {
+				return fmt.Errorf("undefined transform %q", op)
+			}
+			s = fn(s)
+		}
+		rv.SetString(s)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.transform(rv.Index(i), ops); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			if err := v.transform(elem, ops); err != nil {
+				return err
+			}
+			rv.SetMapIndex(iter.Key(), elem)
+		}
+	case reflect.Struct:
+		typ := rv.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+
+			var fieldOps []string
+			if tag := f.Tag.Get(transformTag); tag != "" {
+				fieldOps = strings.Split(tag, ",")
+			}
+			if len(fieldOps) == 0 && !v.hasTag(f.Type, transformTag) {
+				continue
+			}
+			if err := v.transform(rv.Field(i), fieldOps); err != nil {
+				return fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// tagKey identifies the cached result of hasTag.
+type tagKey struct {
+	typ reflect.Type
+	tag string
+}
+
+// hasTag reports whether values of typ may contain input fields with the struct
+// tag, such as a transform tag. The result is cached per type and tag.
+func (v *Validator) hasTag(typ reflect.Type, tag string) bool {
+	key := tagKey{typ: typ, tag: tag}
+	if cached, ok := v.tagCache.Load(key); ok {
+		return cached.(bool)
+	}
+	found := reachesTag(typ, tag, make(map[reflect.Type]struct{}))
+	v.tagCache.Store(key, found)
+	return found
+}
+
+// reachesTag looks for the struct tag in typ, following pointers, lists, maps
+// and struct fields. visiting breaks the cycles of recursive inputs.
+func reachesTag(typ reflect.Type, tag string, visiting map[reflect.Type]struct{}) bool {
+	if _, ok := visiting[typ]; ok {
+		return false
+	}
+	visiting[typ] = struct{}{}
+
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return reachesTag(typ.Elem(), tag, visiting)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			if f.Tag.Get(tag) != "" || reachesTag(f.Type, tag, visiting) {
+				return true
+			}
+		}
+	}
+	return false
+}
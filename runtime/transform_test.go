@@ -0,0 +1,111 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type transformedAddress struct {
+	City string `json:"city" transform:"trim,upper"`
+}
+
+type transformedInput struct {
+	Email     string                        `json:"email" transform:"trim,lower" validate:"email"`
+	Name      *string                       `json:"name" transform:"collapse"`
+	Tags      []string                      `json:"tags" transform:"slug"`
+	Address   *transformedAddress           `json:"address"`
+	Addresses []transformedAddress          `json:"addresses"`
+	ByKind    map[string]transformedAddress `json:"byKind"`
+	Note      string                        `json:"note"`
+}
+
+func (transformedInput) IsValidatable() {}
+
+func TestTransform(t *testing.T) {
+	RegisterArguments("Mutation", "signup",
+		Argument{Name: "code", Rule: "len=6", Message: "{value} is not a code", Transforms: []string{"trim", "upper"}},
+		Argument{Name: "names", Transforms: []string{"nfc"}},
+	)
+	RegisterSkipped("Mutation", "signup", "input")
+	t.Cleanup(func() {
+		arguments.Delete("Mutation.signup")
+		skipped.Delete("Mutation.signup.input")
+	})
+
+	v, err := New(WithTransform("slug", func(s string) string {
+		return strings.ReplaceAll(strings.ToLower(s), " ", "-")
+	}))
+	require.NoError(t, err)
+
+	resolve := func(args map[string]any) (map[string]any, error) {
+		var seen map[string]any
+		_, err := resolveField(context.Background(), v, fieldContext("Mutation", "signup", args), func(ctx context.Context) (any, error) {
+			seen = graphql.GetFieldContext(ctx).Args
+			return nil, nil
+		})
+		return seen, err
+	}
+
+	t.Run("arguments and input fields", func(t *testing.T) {
+		name := "  Jane \t Doe "
+		input := transformedInput{
+			Email:     "  Jane@Example.COM ",
+			Name:      &name,
+			Tags:      []string{"Go Lang", "GraphQL"},
+			Address:   &transformedAddress{City: " berlin "},
+			Addresses: []transformedAddress{{City: "paris "}},
+			ByKind:    map[string]transformedAddress{"home": {City: " rome"}},
+			Note:      "  untouched ",
+		}
+
+		args, err := resolve(map[string]any{
+			"code":  " ab12cd ",
+			"names": []string{"Café"},
+			"input": input,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "AB12CD", args["code"])
+		assert.Equal(t, []string{"Caf\u00e9"}, args["names"])
+
+		got := args["input"].(transformedInput)
+		assert.Equal(t, "jane@example.com", got.Email)
+		assert.Equal(t, " Jane Doe ", *got.Name)
+		assert.Equal(t, []string{"go-lang", "graphql"}, got.Tags)
+		assert.Equal(t, "BERLIN", got.Address.City)
+		assert.Equal(t, "PARIS", got.Addresses[0].City)
+		assert.Equal(t, "ROME", got.ByKind["home"].City)
+		assert.Equal(t, "  untouched ", got.Note)
+	})
+
+	t.Run("before validation", func(t *testing.T) {
+		_, err := resolve(map[string]any{"code": " ab12c "})
+		var gqlErr *gqlerror.Error
+		require.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "AB12C is not a code", gqlErr.Message)
+	})
+
+	t.Run("undefined transform", func(t *testing.T) {
+		v, err := New()
+		require.NoError(t, err)
+
+		fc := &graphql.FieldContext{Args: map[string]any{"input": &transformedInput{Tags: []string{"a"}}}}
+		_, err = v.Middleware()(graphql.WithFieldContext(context.Background(), fc), func(context.Context) (any, error) {
+			return nil, nil
+		})
+		assert.EqualError(t, err, `input: gqlgen-validate: argument input: Tags: undefined transform "slug"`)
+	})
+}
+
+func TestCollapseSpaces(t *testing.T) {
+	assert.Equal(t, "a b c", collapseSpaces("a  b\n\tc"))
+	assert.Equal(t, " a ", collapseSpaces("\t a \n"))
+	assert.Equal(t, "", collapseSpaces(""))
+}
@@ -0,0 +1,286 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/it"
+	"github.com/go-playground/locales/ja"
+	"github.com/go-playground/locales/nl"
+	"github.com/go-playground/locales/pt"
+	"github.com/go-playground/locales/pt_BR"
+	"github.com/go-playground/locales/zh"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	de_translations "github.com/go-playground/validator/v10/translations/de"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	es_translations "github.com/go-playground/validator/v10/translations/es"
+	fr_translations "github.com/go-playground/validator/v10/translations/fr"
+	it_translations "github.com/go-playground/validator/v10/translations/it"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+	nl_translations "github.com/go-playground/validator/v10/translations/nl"
+	pt_translations "github.com/go-playground/validator/v10/translations/pt"
+	pt_BR_translations "github.com/go-playground/validator/v10/translations/pt_BR"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+)
+
+// language bundles a locale with the validator's default translations for it.
+type language struct {
+	locale   func() locales.Translator
+	register func(*validator.Validate, ut.Translator) error
+}
+
+// languages lists the locales supported by WithTranslations.
+var languages = map[string]language{
+	"de":    {de.New, de_translations.RegisterDefaultTranslations},
+	"en":    {en.New, en_translations.RegisterDefaultTranslations},
+	"es":    {es.New, es_translations.RegisterDefaultTranslations},
+	"fr":    {fr.New, fr_translations.RegisterDefaultTranslations},
+	"it":    {it.New, it_translations.RegisterDefaultTranslations},
+	"ja":    {ja.New, ja_translations.RegisterDefaultTranslations},
+	"nl":    {nl.New, nl_translations.RegisterDefaultTranslations},
+	"pt":    {pt.New, pt_translations.RegisterDefaultTranslations},
+	"pt_BR": {pt_BR.New, pt_BR_translations.RegisterDefaultTranslations},
+	"zh":    {zh.New, zh_translations.RegisterDefaultTranslations},
+}
+
+// WithTranslations enables translated error messages for the given locales
+// (de, en, es, fr, it, ja, nl, pt, pt_BR and zh). The validator's default
+// translations are registered for every tag; the first locale is the fallback
+// when none of the request locales is supported.
+func WithTranslations(locales ...string) Option {
+	return func(v *Validator) error {
+		if len(locales) == 0 {
+			return errors.New("WithTranslations requires at least one locale")
+		}
+
+		for _, name := range locales {
+			lang, ok := languages[name]
+			if !ok {
+				return fmt.Errorf("unsupported locale %q", name)
+			}
+
+			if v.translator == nil {
+				v.translator = ut.New(lang.locale())
+			}
+			if err := v.translator.AddTranslator(lang.locale(), true); err != nil {
+				return err
+			}
+
+			trans, _ := v.translator.GetTranslator(name)
+			if err := lang.register(v.validator, trans); err != nil {
+				return fmt.Errorf("register %s translations: %w", name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// WithMessages adds translation keys for locale. Custom messages set through the
+// schema that match a key are translated, with {0} replaced by the field name and
+// {1} by the rule parameter. The locale must be enabled by a preceding
+// WithTranslations option.
+func WithMessages(locale string, messages map[string]string) Option {
+	return func(v *Validator) error {
+		if v.translator == nil {
+			return fmt.Errorf("locale %q is not enabled, add it with WithTranslations", locale)
+		}
+		trans, ok := v.translator.GetTranslator(locale)
+		if !ok {
+			return fmt.Errorf("locale %q is not enabled, add it with WithTranslations", locale)
+		}
+
+		for key, text := range messages {
+			if err := trans.Add(key, text, true); err != nil {
+				return fmt.Errorf("add %s message %q: %w", locale, key, err)
+			}
+		}
+		return nil
+	}
+}
+
+// WithLocale overrides how the request locales are extracted from the context.
+// The function returns locales in order of preference. AcceptLanguage is used by
+// default.
+func WithLocale(fn func(ctx context.Context) []string) Option {
+	return func(v *Validator) error {
+		v.locales = fn
+		return nil
+	}
+}
+
+// AcceptLanguage returns the locales of the Accept-Language header sent with the
+// current GraphQL operation, most preferred first.
+func AcceptLanguage(ctx context.Context) []string {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Headers == nil {
+		return nil
+	}
+	return parseAcceptLanguage(oc.Headers.Get("Accept-Language"))
+}
+
+// parseAcceptLanguage orders the language ranges of an Accept-Language header by
+// quality and converts them to the underscore form used by locales (pt-BR becomes
+// pt_BR). Base languages are appended so that de-CH still matches de.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, weighted{locale: strings.ReplaceAll(tag, "-", "_"), q: q})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	seen := make(map[string]struct{}, len(ranges))
+	out := make([]string, 0, len(ranges))
+	add := func(locale string) {
+		if _, ok := seen[locale]; !ok {
+			seen[locale] = struct{}{}
+			out = append(out, locale)
+		}
+	}
+	for _, r := range ranges {
+		add(r.locale)
+	}
+	for _, r := range ranges {
+		if base, _, ok := strings.Cut(r.locale, "_"); ok {
+			add(base)
+		}
+	}
+	return out
+}
+
+// translatorFor returns the translator matching the request locales, or nil when
+// translations are disabled.
+func (v *Validator) translatorFor(ctx context.Context) ut.Translator {
+	if v.translator == nil {
+		return nil
+	}
+
+	trans, _ := v.translator.FindTranslator(v.requestLocales(ctx)...)
+	return trans
+}
+
+// requestLocales returns the locales preferred by the current request.
+func (v *Validator) requestLocales(ctx context.Context) []string {
+	if v.locales == nil {
+		return nil
+	}
+	return v.locales(ctx)
+}
+
+// messageID identifies a custom message by rule tag and locale. Either may be
+// empty.
+type messageID struct {
+	rule   string
+	locale string
+}
+
+// customMessages collects the message-<locale>, message_<rule> and
+// message_<rule>-<locale> tags of a struct field.
+func customMessages(tag reflect.StructTag) map[messageID]string {
+	var out map[messageID]string
+
+	rest := string(tag)
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ")
+		key, value, ok := strings.Cut(rest, ":")
+		if !ok || !strings.HasPrefix(value, `"`) {
+			break
+		}
+
+		// Find the closing quote, skipping escaped characters.
+		end := 1
+		for end < len(value) && value[end] != '"' {
+			if value[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(value) {
+			break
+		}
+		quoted := value[:end+1]
+		rest = value[end+1:]
+
+		id, ok := parseMessageKey(key)
+		if !ok {
+			continue
+		}
+		text, err := strconv.Unquote(quoted)
+		if err != nil {
+			continue
+		}
+		if out == nil {
+			out = make(map[messageID]string)
+		}
+		out[id] = text
+	}
+	return out
+}
+
+// translateMessage treats msg as a translation key and falls back to the literal
+// text when trans does not know it.
+func translateMessage(trans ut.Translator, msg string, fieldError validator.FieldError) string {
+	if trans == nil {
+		return msg
+	}
+	if translated, err := trans.T(msg, fieldError.Field(), fieldError.Param()); err == nil {
+		return translated
+	}
+	return msg
+}
+
+// parseMessageKey splits a message tag key into its rule and locale.
+func parseMessageKey(key string) (messageID, bool) {
+	if locale, ok := strings.CutPrefix(key, "message-"); ok {
+		return messageID{locale: locale}, locale != ""
+	}
+
+	rest, ok := strings.CutPrefix(key, "message_")
+	if !ok {
+		return messageID{}, false
+	}
+	rule, locale, hasLocale := strings.Cut(rest, "-")
+	if rule == "" || (hasLocale && locale == "") {
+		return messageID{}, false
+	}
+	return messageID{rule: rule, locale: locale}, true
+}
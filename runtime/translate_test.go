@@ -0,0 +1,268 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type keyedInput struct {
+	Name string `json:"name" validate:"required" message:"name.required"`
+	Nick string `json:"nick" validate:"required" message:"Nick is missing"`
+}
+
+func (keyedInput) IsValidatable() {}
+
+type localizedInput struct {
+	Password string `json:"password" validate:"min=8" message:"too short" messageKey:"password.short" message-de:"Mindestens 8 Zeichen" message-pt_BR:"Pelo menos \"8\" caracteres"`
+}
+
+func (localizedInput) IsValidatable() {}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		header string
+		want   []string
+	}{
+		{header: "", want: []string{}},
+		{header: "de", want: []string{"de"}},
+		{header: "de-CH,de;q=0.9,en;q=0.8", want: []string{"de_CH", "de", "en"}},
+		{header: "en;q=0.5, pt-BR", want: []string{"pt_BR", "en", "pt"}},
+		{header: "fr;q=0, *, it;q=bad, es", want: []string{"es"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.header, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, parseAcceptLanguage(tc.header))
+		})
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	assert.Nil(t, AcceptLanguage(context.Background()))
+
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		Headers: http.Header{"Accept-Language": []string{"de-DE,en;q=0.5"}},
+	})
+	assert.Equal(t, []string{"de_DE", "en", "de"}, AcceptLanguage(ctx))
+}
+
+func TestTranslations(t *testing.T) {
+	v, err := New(
+		WithTranslations("en", "de"),
+		WithMessages("de", map[string]string{"name.required": "Bitte {0} angeben"}),
+		WithMessages("en", map[string]string{"name.required": "Please enter a {0}"}),
+	)
+	require.NoError(t, err)
+
+	withLocale := func(header string) context.Context {
+		ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Headers: http.Header{"Accept-Language": []string{header}},
+		})
+		return graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	}
+
+	messages := func(ctx context.Context, value any) []string {
+		t.Helper()
+
+		var errs gqlerror.List
+		require.True(t, errors.As(v.Validate(ctx, value), &errs))
+
+		out := make([]string, 0, len(errs))
+		for _, e := range errs {
+			out = append(out, e.Message)
+		}
+		return out
+	}
+
+	t.Run("default messages", func(t *testing.T) {
+		assert.Equal(t, []string{"name ist ein Pflichtfeld"}, messages(withLocale("de-CH"), &simplePointer{}))
+		assert.Equal(t, []string{"name is a required field"}, messages(withLocale("en"), &simplePointer{}))
+	})
+
+	t.Run("falls back to the first locale", func(t *testing.T) {
+		assert.Equal(t, []string{"name is a required field"}, messages(withLocale("ja"), &simplePointer{}))
+		assert.Equal(t, []string{"name is a required field"}, messages(context.Background(), &simplePointer{}))
+	})
+
+	t.Run("message keys", func(t *testing.T) {
+		assert.Equal(t, []string{"Bitte name angeben", "Nick is missing"}, messages(withLocale("de"), &keyedInput{}))
+		assert.Equal(t, []string{"Please enter a name", "Nick is missing"}, messages(withLocale("en"), &keyedInput{}))
+	})
+
+	t.Run("arguments", func(t *testing.T) {
+		errs, err := v.checkRule(withLocale("de"), Argument{Name: "limit", Rule: "required"}, (*int)(nil))
+		require.NoError(t, err)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "limit ist ein Pflichtfeld", errs[0].message)
+	})
+
+	t.Run("custom locale extractor", func(t *testing.T) {
+		v, err := New(WithTranslations("en", "fr"), WithLocale(func(context.Context) []string { return []string{"fr"} }))
+		require.NoError(t, err)
+
+		var errs gqlerror.List
+		require.True(t, errors.As(v.Validate(context.Background(), &simplePointer{}), &errs))
+		assert.Equal(t, "name est un champ obligatoire", errs[0].Message)
+	})
+}
+
+func TestLocalizedMessages(t *testing.T) {
+	withLocale := func(header string) context.Context {
+		return graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Headers: http.Header{"Accept-Language": []string{header}},
+		})
+	}
+
+	message := func(v *Validator, ctx context.Context, value any) string {
+		t.Helper()
+
+		var errs gqlerror.List
+		require.True(t, errors.As(v.Validate(ctx, value), &errs))
+		require.Len(t, errs, 1)
+		return errs[0].Message
+	}
+
+	t.Run("without translations", func(t *testing.T) {
+		v := newValidator()
+
+		assert.Equal(t, "Mindestens 8 Zeichen", message(v, withLocale("de-AT"), &localizedInput{}))
+		assert.Equal(t, `Pelo menos "8" caracteres`, message(v, withLocale("pt-BR"), &localizedInput{}))
+		assert.Equal(t, "too short", message(v, withLocale("fr"), &localizedInput{}))
+	})
+
+	t.Run("with translations", func(t *testing.T) {
+		v, err := New(
+			WithTranslations("en", "de", "fr"),
+			WithMessages("en", map[string]string{"password.short": "{0} needs {1} characters"}),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Mindestens 8 Zeichen", message(v, withLocale("de"), &localizedInput{}))
+		assert.Equal(t, "password needs 8 characters", message(v, withLocale("en"), &localizedInput{}))
+		// fr has no localized message and no translation for the key.
+		assert.Equal(t, "too short", message(v, withLocale("fr"), &localizedInput{}))
+	})
+
+	t.Run("fallback locale", func(t *testing.T) {
+		v, err := New(WithTranslations("de", "en"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Mindestens 8 Zeichen", message(v, withLocale("ja"), &localizedInput{}))
+	})
+
+	t.Run("arguments", func(t *testing.T) {
+		v := newValidator()
+		arg := Argument{Name: "id", Rule: "uuid4", Message: "bad id", Messages: []Message{
+			{Locale: "de", Text: "ungültige ID"},
+			{Locale: "fr", Text: "identifiant invalide"},
+		}}
+
+		for header, want := range map[string]string{"fr-CA": "identifiant invalide", "de": "ungültige ID", "en": "bad id"} {
+			errs, err := v.checkRule(withLocale(header), arg, "42")
+			require.NoError(t, err)
+			require.Len(t, errs, 1)
+			assert.Equal(t, want, errs[0].message, header)
+		}
+	})
+}
+
+func TestCustomMessageTags(t *testing.T) {
+	t.Parallel()
+
+	tag := reflect.StructTag(`json:"name" message:"x" message-de:"a \"b\"" message-:"ignored" message-fr:"c:d"` +
+		` message_min:"short" message_required_if-pt_BR:"obrigatório" message_:"ignored" message_max-:"ignored"`)
+	assert.Equal(t, map[messageID]string{
+		{locale: "de"}:                         `a "b"`,
+		{locale: "fr"}:                         "c:d",
+		{rule: "min"}:                          "short",
+		{rule: "required_if", locale: "pt_BR"}: "obrigatório",
+	}, customMessages(tag))
+	assert.Nil(t, customMessages(`json:"name"`))
+}
+
+func TestTranslationOptionErrors(t *testing.T) {
+	_, err := New(WithTranslations())
+	assert.EqualError(t, err, "gqlgen-validate: WithTranslations requires at least one locale")
+
+	_, err = New(WithTranslations("tlh"))
+	assert.EqualError(t, err, `gqlgen-validate: unsupported locale "tlh"`)
+
+	_, err = New(WithMessages("de", map[string]string{"key": "text"}))
+	assert.EqualError(t, err, `gqlgen-validate: locale "de" is not enabled, add it with WithTranslations`)
+
+	_, err = New(WithTranslations("en"), WithMessages("de", map[string]string{"key": "text"}))
+	assert.EqualError(t, err, `gqlgen-validate: locale "de" is not enabled, add it with WithTranslations`)
+}
+
+type ruleMessageInput struct {
+	Password string `json:"password" validate:"required,min=8,max=64" message:"invalid password" message-de:"ungültiges Passwort" message_required:"Password is required" message_min:"At least {param} characters" message_min-de:"Mindestens {param} Zeichen"`
+}
+
+func (ruleMessageInput) IsValidatable() {}
+
+func TestRuleMessages(t *testing.T) {
+	v := newValidator()
+
+	withLocale := func(header string) context.Context {
+		return graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Headers: http.Header{"Accept-Language": []string{header}},
+		})
+	}
+
+	cases := []struct {
+		name     string
+		locale   string
+		password string
+		want     string
+	}{
+		{name: "rule message", locale: "en", password: "", want: "Password is required"},
+		{name: "rule message with placeholder", locale: "en", password: "short", want: "At least 8 characters"},
+		{name: "localized rule message", locale: "de", password: "short", want: "Mindestens 8 Zeichen"},
+		{name: "rule message before localized field message", locale: "de", password: "", want: "Password is required"},
+		{name: "localized field message", locale: "de", password: strings.Repeat("x", 65), want: "ungültiges Passwort"},
+		{name: "field message", locale: "en", password: strings.Repeat("x", 65), want: "invalid password"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs gqlerror.List
+			require.True(t, errors.As(v.Validate(withLocale(tc.locale), &ruleMessageInput{Password: tc.password}), &errs))
+			require.Len(t, errs, 1)
+			assert.Equal(t, tc.want, errs[0].Message)
+		})
+	}
+
+	t.Run("arguments", func(t *testing.T) {
+		arg := Argument{Name: "limit", Rule: "gte=1,lte=100", Message: "invalid limit", Messages: []Message{
+			{Rule: "gte", Text: "{field} must be positive"},
+			{Rule: "lte", Locale: "fr", Text: "{field} doit être au plus {param}"},
+		}}
+
+		for _, tc := range []struct {
+			locale string
+			limit  int
+			want   string
+		}{
+			{locale: "en", limit: 0, want: "limit must be positive"},
+			{locale: "fr", limit: 101, want: "limit doit être au plus 100"},
+			{locale: "en", limit: 101, want: "invalid limit"},
+		} {
+			errs, err := v.checkRule(withLocale(tc.locale), arg, tc.limit)
+			require.NoError(t, err)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tc.want, errs[0].message)
+		}
+	})
+}